@@ -42,6 +42,14 @@ var (
 	ignoreViews  string
 	ignoreEnums  string
 
+	deduplicateEnums bool
+
+	nullable string
+	decimal  string
+
+	softDeleteColumn string
+	versionColumn    string
+
 	destDir string
 )
 
@@ -69,6 +77,14 @@ func init() {
 	flag.StringVar(&ignoreTables, "ignore-tables", "", `Comma-separated list of tables to ignore`)
 	flag.StringVar(&ignoreViews, "ignore-views", "", `Comma-separated list of views to ignore`)
 	flag.StringVar(&ignoreEnums, "ignore-enums", "", `Comma-separated list of enums to ignore`)
+	flag.BoolVar(&deduplicateEnums, "deduplicate-enums", false, `Generate single type for the enums with the same list of values, for instance MySQL ENUM and SET columns. Ignored enums are not de-duplicated. (optional)`)
+
+	flag.StringVar(&nullable, "nullable", "pointer", `Model field type for nullable columns: pointer, sql (database/sql Null types), generic (qrm.Null[T]) or guregu (gopkg.in/guregu/null.v4 types). (default "pointer")`)
+	flag.StringVar(&decimal, "decimal", "float", `Model field type for numeric and decimal columns: float (float64), shopspring (github.com/shopspring/decimal Decimal) or jet (qrm.Decimal). If not float, sql builder numeric and decimal columns are generated as decimal columns. (default "float")`)
+
+	flag.StringVar(&softDeleteColumn, "soft-delete-column", "", `Name of the nullable soft delete column. Tables with the column are generated as soft delete tables. (optional)`)
+
+	flag.StringVar(&versionColumn, "version-column", "", `Name of the not null integer optimistic lock version column. UPDATE statement MODEL of the tables with the column increments and checks the version. (optional)`)
 
 	flag.StringVar(&destDir, "path", "", "Destination dir for files generated.")
 }
@@ -85,12 +101,16 @@ func main() {
 	ignoreTablesList := parseList(ignoreTables)
 	ignoreViewsList := parseList(ignoreViews)
 	ignoreEnumsList := parseList(ignoreEnums)
+	fieldTypes := template.ModelFieldTypes{
+		Nullable: parseNullableStrategy(nullable),
+		Decimal:  parseDecimalType(decimal),
+	}
 
 	var err error
 
 	switch source {
 	case "postgresql", "postgres", "cockroachdb", "cockroach":
-		generatorTemplate := genTemplate(postgres2.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList)
+		generatorTemplate := genTemplate(postgres2.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList, deduplicateEnums, fieldTypes, softDeleteColumn, versionColumn)
 
 		if dsn != "" {
 			err = postgresgen.GenerateDSN(dsn, schemaName, destDir, generatorTemplate)
@@ -116,7 +136,7 @@ func main() {
 		)
 
 	case "mysql", "mysqlx", "mariadb":
-		generatorTemplate := genTemplate(mysql.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList)
+		generatorTemplate := genTemplate(mysql.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList, deduplicateEnums, fieldTypes, softDeleteColumn, versionColumn)
 
 		if dsn != "" {
 			err = mysqlgen.GenerateDSN(dsn, destDir, generatorTemplate)
@@ -145,7 +165,7 @@ func main() {
 		err = sqlitegen.GenerateDSN(
 			dsn,
 			destDir,
-			genTemplate(sqlite.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList),
+			genTemplate(sqlite.Dialect, ignoreTablesList, ignoreViewsList, ignoreEnumsList, deduplicateEnums, fieldTypes, softDeleteColumn, versionColumn),
 		)
 
 	case "":
@@ -169,7 +189,9 @@ func usage() {
 	order := []string{
 		"source", "dsn", "host", "port", "user", "password", "dbname", "schema", "params", "sslmode",
 		"path",
-		"ignore-tables", "ignore-views", "ignore-enums",
+		"ignore-tables", "ignore-views", "ignore-enums", "deduplicate-enums",
+		"nullable", "decimal",
+		"soft-delete-column", "version-column",
 	}
 
 	for _, name := range order {
@@ -229,7 +251,32 @@ func parseList(list string) []string {
 	return ret
 }
 
-func genTemplate(dialect jet.Dialect, ignoreTables []string, ignoreViews []string, ignoreEnums []string) template.Template {
+func parseNullableStrategy(nullable string) template.NullableStrategy {
+	strategy := template.NullableStrategy(strings.ToLower(strings.TrimSpace(nullable)))
+
+	switch strategy {
+	case template.NullablePointer, template.NullableSQL, template.NullableGeneric, template.NullableGuregu:
+		return strategy
+	}
+
+	printErrorAndExit("ERROR: unknown nullable strategy " + nullable + ". Only pointer, sql, generic and guregu are supported.")
+	return ""
+}
+
+func parseDecimalType(decimal string) template.DecimalType {
+	decimalType := template.DecimalType(strings.ToLower(strings.TrimSpace(decimal)))
+
+	switch decimalType {
+	case template.DecimalFloat, template.DecimalShopspring, template.DecimalJet:
+		return decimalType
+	}
+
+	printErrorAndExit("ERROR: unknown decimal type " + decimal + ". Only float, shopspring and jet are supported.")
+	return ""
+}
+
+func genTemplate(dialect jet.Dialect, ignoreTables []string, ignoreViews []string, ignoreEnums []string, deduplicateEnums bool,
+	fieldTypes template.ModelFieldTypes, softDeleteColumn, versionColumn string) template.Template {
 
 	shouldSkipTable := func(table metadata.Table) bool {
 		return strslice.Contains(ignoreTables, strings.ToLower(table.Name))
@@ -243,27 +290,37 @@ func genTemplate(dialect jet.Dialect, ignoreTables []string, ignoreViews []strin
 		return strslice.Contains(ignoreEnums, strings.ToLower(enum.Name))
 	}
 
+	sqlBuilderColumn := template.DefaultTableSQLBuilderColumn
+
+	if fieldTypes.Decimal != template.DecimalFloat {
+		sqlBuilderColumn = template.DecimalTableSQLBuilderColumn
+	}
+
 	return template.Default(dialect).
 		UseSchema(func(schemaMetaData metadata.Schema) template.Schema {
 			return template.DefaultSchema(schemaMetaData).
+				UseDeduplicateEnums(deduplicateEnums).
 				UseModel(template.DefaultModel().
 					UseTable(func(table metadata.Table) template.TableModel {
 						if shouldSkipTable(table) {
 							return template.TableModel{Skip: true}
 						}
-						return template.DefaultTableModel(table)
+						return template.DefaultTableModel(table).UseFieldTypes(fieldTypes)
 					}).
 					UseView(func(view metadata.Table) template.ViewModel {
 						if shouldSkipView(view) {
 							return template.ViewModel{Skip: true}
 						}
-						return template.DefaultViewModel(view)
+						return template.DefaultViewModel(view).UseFieldTypes(fieldTypes)
 					}).
 					UseEnum(func(enum metadata.Enum) template.EnumModel {
 						if shouldSkipEnum(enum) {
 							return template.EnumModel{Skip: true}
 						}
 						return template.DefaultEnumModel(enum)
+					}).
+					UseComposite(func(composite metadata.Composite) template.CompositeModel {
+						return template.DefaultCompositeModel(composite).UseFieldTypes(fieldTypes)
 					}),
 				).
 				UseSQLBuilder(template.DefaultSQLBuilder().
@@ -271,13 +328,16 @@ func genTemplate(dialect jet.Dialect, ignoreTables []string, ignoreViews []strin
 						if shouldSkipTable(table) {
 							return template.TableSQLBuilder{Skip: true}
 						}
-						return template.DefaultTableSQLBuilder(table)
+						return template.DefaultTableSQLBuilder(table).
+							UseColumn(sqlBuilderColumn).
+							UseSoftDeleteColumn(template.SoftDeleteColumn(table, softDeleteColumn)).
+							UseVersionColumn(template.VersionColumn(table, versionColumn))
 					}).
 					UseView(func(table metadata.Table) template.ViewSQLBuilder {
 						if shouldSkipView(table) {
 							return template.ViewSQLBuilder{Skip: true}
 						}
-						return template.DefaultViewSQLBuilder(table)
+						return template.DefaultViewSQLBuilder(table).UseColumn(sqlBuilderColumn)
 					}).
 					UseEnum(func(enum metadata.Enum) template.EnumSQLBuilder {
 						if shouldSkipEnum(enum) {
@@ -0,0 +1,78 @@
+// Package dialect is an extension point for defining custom SQL dialects, like CockroachDB, TiDB or DuckDB specific
+// variants of the supported databases. New dialect can be defined from scratch with New, or derived from the dialect
+// of an existing dialect package with Extend. Statement builders of the existing dialect package are reused, and
+// statements are serialized with the custom dialect using Statement WithDialect method:
+//
+//	stmt := postgres.SELECT(table.ID).FROM(table).WithDialect(cockroach)
+package dialect
+
+import (
+	"strconv"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Dialect is SQL dialect used to serialize statements
+type Dialect = jet.Dialect
+
+// Params are the settings of the new dialect
+type Params = jet.DialectParams
+
+// SerializeOverride creates custom serializer for the operator or function expressions
+type SerializeOverride = jet.SerializeOverride
+
+// SerializerFunc serializes expression into SQL builder
+type SerializerFunc = jet.SerializerFunc
+
+// QueryPlaceholderFunc returns query argument placeholder for the argument ordinal number
+type QueryPlaceholderFunc = jet.QueryPlaceholderFunc
+
+// Serializer is implemented by all the clauses and expressions that can be serialized into SQL builder
+type Serializer = jet.Serializer
+
+// SerializeOption changes the way expression is serialized
+type SerializeOption = jet.SerializeOption
+
+// SQLBuilder accumulates the SQL query text and the query arguments
+type SQLBuilder = jet.SQLBuilder
+
+// StatementType is the type of the statement being serialized
+type StatementType = jet.StatementType
+
+// Expression is common interface for all the expressions
+type Expression = jet.Expression
+
+// Format is the SQL formatting setting of the dialect
+type Format = jet.Format
+
+// StringConcatOperator is the name of the string concatenation operator, that can be used as a key in
+// Params.OperatorSerializeOverrides
+const StringConcatOperator = jet.StringConcatOperator
+
+// New creates new dialect from the params
+func New(params Params) Dialect {
+	return jet.NewDialect(params)
+}
+
+// Extend creates new dialect from the base dialect, for instance postgres.Dialect. Params fields that are set override
+// the base dialect settings, while params operator and function overrides and reserved words are added to the base
+// dialect ones.
+func Extend(base Dialect, params Params) Dialect {
+	return jet.ExtendDialect(base, params)
+}
+
+// Serialize serializes expression into SQL builder, and should be used by the custom serializers
+var Serialize = jet.Serialize
+
+// SerializeForOrderBy serializes expression as ORDER BY clause item, and should be used by custom ORDER BY serializers
+var SerializeForOrderBy = jet.SerializeForOrderBy
+
+// QuestionMarkPlaceholder is argument placeholder used by MySQL and SQLite like databases: ?
+func QuestionMarkPlaceholder(int) string {
+	return "?"
+}
+
+// DollarPlaceholder is argument placeholder used by PostgreSQL like databases: $1, $2, ...
+func DollarPlaceholder(ord int) string {
+	return "$" + strconv.Itoa(ord)
+}
@@ -0,0 +1,94 @@
+package dialect_test
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/dialect"
+	"github.com/go-jet/jet/v2/internal/testutils"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	colID   = postgres.IntegerColumn("id")
+	colName = postgres.StringColumn("name")
+	colKey  = postgres.StringColumn("key")
+	table   = postgres.NewTable("db", "users", "", colID, colName, colKey)
+)
+
+func concatFunc(expressions ...dialect.Serializer) dialect.SerializerFunc {
+	return func(statement dialect.StatementType, out *dialect.SQLBuilder, options ...dialect.SerializeOption) {
+		out.WriteString("CONCAT(")
+		dialect.Serialize(expressions[0], statement, out, options...)
+		out.WriteString(", ")
+		dialect.Serialize(expressions[1], statement, out, options...)
+		out.WriteString(")")
+	}
+}
+
+func TestExtend(t *testing.T) {
+	custom := dialect.Extend(postgres.Dialect, dialect.Params{
+		Name:                "Custom",
+		ArgumentPlaceholder: dialect.QuestionMarkPlaceholder,
+		ReservedWords:       []string{"KEY"},
+		OperatorSerializeOverrides: map[string]dialect.SerializeOverride{
+			dialect.StringConcatOperator: concatFunc,
+		},
+	})
+
+	require.Equal(t, "Custom", custom.Name())
+	require.Equal(t, "postgres", custom.PackageName())
+	require.True(t, custom.IsReservedWord("key"))
+	require.True(t, custom.IsReservedWord("select"))
+
+	stmt := postgres.SELECT(colID, colName.CONCAT(colKey)).
+		FROM(table).
+		WHERE(colID.GT(postgres.Int(2)))
+
+	testutils.AssertStatementSql(t, stmt.WithDialect(custom), `
+SELECT users.id AS "users.id",
+     CONCAT(users.name, users."key")
+FROM db.users
+WHERE users.id > ?;
+`, int64(2))
+
+	testutils.AssertStatementSql(t, stmt, `
+SELECT users.id AS "users.id",
+     users.name || users.key
+FROM db.users
+WHERE users.id > $1;
+`, int64(2))
+}
+
+func TestNew(t *testing.T) {
+	custom := dialect.New(dialect.Params{
+		Name:                "Custom",
+		PackageName:         "custom",
+		AliasQuoteChar:      '"',
+		IdentifierQuoteChar: '`',
+		ArgumentPlaceholder: dialect.DollarPlaceholder,
+		ReservedWords:       []string{"name"},
+		SerializeOrderBy: func(expression dialect.Expression, ascending, nullsFirst *bool) dialect.SerializerFunc {
+			return func(statement dialect.StatementType, out *dialect.SQLBuilder, options ...dialect.SerializeOption) {
+				dialect.SerializeForOrderBy(expression, statement, out)
+
+				if ascending != nil && !*ascending {
+					out.WriteString("DESC")
+				}
+			}
+		},
+	})
+
+	stmt := postgres.SELECT(colName).
+		FROM(table).
+		WHERE(colID.EQ(postgres.Int(1))).
+		ORDER_BY(colName.DESC().NULLS_LAST())
+
+	testutils.AssertStatementSql(t, stmt.WithDialect(custom), "\nSELECT users.`name` AS \"users.name\"\nFROM db.users\nWHERE users.id = $1\nORDER BY users.`name` DESC;\n", int64(1))
+}
+
+func TestWithDialectNil(t *testing.T) {
+	require.PanicsWithValue(t, "jet: dialect is nil", func() {
+		postgres.SELECT(colID).WithDialect(nil)
+	})
+}
@@ -11,7 +11,7 @@ type Column struct {
 	Comment      string
 }
 
-// DataTypeKind is database type kind(base, enum, user-defined, array)
+// DataTypeKind is database type kind(base, enum, user-defined, array, range, composite, set)
 type DataTypeKind string
 
 // DataTypeKind possible values
@@ -21,6 +21,8 @@ const (
 	UserDefinedType DataTypeKind = "user-defined"
 	ArrayType       DataTypeKind = "array"
 	RangeType       DataTypeKind = "range"
+	CompositeType   DataTypeKind = "composite"
+	SetType         DataTypeKind = "set"
 )
 
 // DataType contains information about column data type
@@ -28,4 +30,7 @@ type DataType struct {
 	Name       string
 	Kind       DataTypeKind
 	IsUnsigned bool
+	// DomainName is the name of the domain type, if the column type is a domain. Name and Kind are then the name
+	// and the kind of domain base type.
+	DomainName string
 }
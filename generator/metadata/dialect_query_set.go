@@ -20,6 +20,27 @@ type DialectQuerySet interface {
 	GetEnumsMetaData(db *sql.DB, schemaName string) ([]Enum, error)
 }
 
+// RoutinesQuerySet is implemented by dialect query sets able to retrieve stored functions and procedures metadata
+type RoutinesQuerySet interface {
+	GetRoutinesMetaData(db *sql.DB, schemaName string) ([]Routine, error)
+}
+
+// SequencesQuerySet is implemented by dialect query sets able to retrieve sequences metadata
+type SequencesQuerySet interface {
+	GetSequencesMetaData(db *sql.DB, schemaName string) ([]Sequence, error)
+}
+
+// SetsQuerySet is implemented by dialect query sets able to retrieve set types metadata
+type SetsQuerySet interface {
+	GetSetsMetaData(db *sql.DB, schemaName string) ([]Set, error)
+}
+
+// UserDefinedTypesQuerySet is implemented by dialect query sets able to retrieve composite and domain types metadata
+type UserDefinedTypesQuerySet interface {
+	GetCompositesMetaData(db *sql.DB, schemaName string) ([]Composite, error)
+	GetDomainsMetaData(db *sql.DB, schemaName string) ([]Domain, error)
+}
+
 // GetSchema retrieves Schema information from database
 func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) (Schema, error) {
 	tablesMetaData, err := querySet.GetTablesMetaData(db, schemaName, BaseTable)
@@ -37,15 +58,63 @@ func GetSchema(db *sql.DB, querySet DialectQuerySet, schemaName string) (Schema,
 		return Schema{}, fmt.Errorf("failed to get %s enum metadata: %w", schemaName, err)
 	}
 
+	var routinesMetaData []Routine
+
+	if routinesQuerySet, ok := querySet.(RoutinesQuerySet); ok {
+		routinesMetaData, err = routinesQuerySet.GetRoutinesMetaData(db, schemaName)
+		if err != nil {
+			return Schema{}, fmt.Errorf("failed to get %s routines metadata: %w", schemaName, err)
+		}
+	}
+
+	var sequencesMetaData []Sequence
+
+	if sequencesQuerySet, ok := querySet.(SequencesQuerySet); ok {
+		sequencesMetaData, err = sequencesQuerySet.GetSequencesMetaData(db, schemaName)
+		if err != nil {
+			return Schema{}, fmt.Errorf("failed to get %s sequences metadata: %w", schemaName, err)
+		}
+	}
+
+	var setsMetaData []Set
+
+	if setsQuerySet, ok := querySet.(SetsQuerySet); ok {
+		setsMetaData, err = setsQuerySet.GetSetsMetaData(db, schemaName)
+		if err != nil {
+			return Schema{}, fmt.Errorf("failed to get %s set types metadata: %w", schemaName, err)
+		}
+	}
+
+	var compositesMetaData []Composite
+	var domainsMetaData []Domain
+
+	if userDefinedTypesQuerySet, ok := querySet.(UserDefinedTypesQuerySet); ok {
+		compositesMetaData, err = userDefinedTypesQuerySet.GetCompositesMetaData(db, schemaName)
+		if err != nil {
+			return Schema{}, fmt.Errorf("failed to get %s composite types metadata: %w", schemaName, err)
+		}
+
+		domainsMetaData, err = userDefinedTypesQuerySet.GetDomainsMetaData(db, schemaName)
+		if err != nil {
+			return Schema{}, fmt.Errorf("failed to get %s domain types metadata: %w", schemaName, err)
+		}
+	}
+
 	ret := Schema{
-		Name:           schemaName,
-		TablesMetaData: tablesMetaData,
-		ViewsMetaData:  viewMetaData,
-		EnumsMetaData:  enumsMetaData,
+		Name:               schemaName,
+		TablesMetaData:     tablesMetaData,
+		ViewsMetaData:      viewMetaData,
+		EnumsMetaData:      enumsMetaData,
+		RoutinesMetaData:   routinesMetaData,
+		SequencesMetaData:  sequencesMetaData,
+		CompositesMetaData: compositesMetaData,
+		DomainsMetaData:    domainsMetaData,
+		SetsMetaData:       setsMetaData,
 	}
 
 	fmt.Println("	FOUND", len(ret.TablesMetaData), "table(s),", len(ret.ViewsMetaData), "view(s),",
-		len(ret.EnumsMetaData), "enum(s)")
+		len(ret.EnumsMetaData), "enum(s),", len(ret.SetsMetaData), "set(s),", len(ret.RoutinesMetaData), "routine(s),", len(ret.SequencesMetaData),
+		"sequence(s),", len(ret.CompositesMetaData), "composite(s),", len(ret.DomainsMetaData), "domain(s)")
 
 	return ret, nil
 }
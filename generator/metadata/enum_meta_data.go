@@ -6,3 +6,6 @@ type Enum struct {
 	Comment string
 	Values  []string
 }
+
+// Set metadata struct, describing MySQL SET type with its possible members
+type Set = Enum
@@ -0,0 +1,40 @@
+package metadata
+
+// RoutineKind is kind of database routine(function or procedure)
+type RoutineKind string
+
+// RoutineKind possible values
+const (
+	FunctionRoutine  RoutineKind = "function"
+	ProcedureRoutine RoutineKind = "procedure"
+)
+
+// Routine metadata struct, describing database stored function or procedure
+type Routine struct {
+	Name       string
+	Kind       RoutineKind
+	Comment    string
+	ReturnsSet bool
+	// ReturnType is the data type of scalar function result
+	ReturnType DataType
+	// Arguments are routine input arguments
+	Arguments []RoutineArgument
+	// Columns are result columns of the functions returning rows
+	Columns []Column
+}
+
+// IsProcedure returns true if routine is stored procedure
+func (r Routine) IsProcedure() bool {
+	return r.Kind == ProcedureRoutine
+}
+
+// IsTableFunction returns true if routine is a function returning rows, that can be used as a table in FROM clause
+func (r Routine) IsTableFunction() bool {
+	return r.Kind == FunctionRoutine && len(r.Columns) > 0
+}
+
+// RoutineArgument metadata struct
+type RoutineArgument struct {
+	Name     string
+	DataType DataType
+}
@@ -6,9 +6,22 @@ type Schema struct {
 	TablesMetaData []Table
 	ViewsMetaData  []Table
 	EnumsMetaData  []Enum
+	// RoutinesMetaData are stored functions and procedures metadata, if supported by the dialect
+	RoutinesMetaData []Routine
+	// SequencesMetaData are sequences metadata, if supported by the dialect
+	SequencesMetaData []Sequence
+	// CompositesMetaData are composite types metadata, if supported by the dialect
+	CompositesMetaData []Composite
+	// DomainsMetaData are domain types metadata, if supported by the dialect
+	DomainsMetaData []Domain
+	// SetsMetaData are set types metadata, if supported by the dialect
+	SetsMetaData []Set
 }
 
-// IsEmpty returns true if schema info does not contain any table, views or enums metadata
+// IsEmpty returns true if schema info does not contain any table, views, enums, sets, routines, sequences or
+// user-defined types metadata
 func (s Schema) IsEmpty() bool {
-	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0
+	return len(s.TablesMetaData) == 0 && len(s.ViewsMetaData) == 0 && len(s.EnumsMetaData) == 0 &&
+		len(s.SetsMetaData) == 0 && len(s.RoutinesMetaData) == 0 && len(s.SequencesMetaData) == 0 &&
+		len(s.CompositesMetaData) == 0 && len(s.DomainsMetaData) == 0
 }
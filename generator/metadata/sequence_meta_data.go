@@ -0,0 +1,7 @@
+package metadata
+
+// Sequence metadata struct
+type Sequence struct {
+	Name    string `sql:"primary_key"`
+	Comment string
+}
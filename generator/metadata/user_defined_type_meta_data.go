@@ -0,0 +1,15 @@
+package metadata
+
+// Composite metadata struct, describing composite (row) type
+type Composite struct {
+	Name       string `sql:"primary_key"`
+	Comment    string
+	Attributes []Column
+}
+
+// Domain metadata struct, describing domain type with its base type
+type Domain struct {
+	Name     string `sql:"primary_key"`
+	Comment  string
+	DataType DataType
+}
@@ -24,11 +24,11 @@ SELECT
 		COALESCE(pk.IsPrimaryKey, 0) AS "column.IsPrimaryKey",
 		IF (col.COLUMN_TYPE = 'tinyint(1)',
 				'boolean',
-				IF (col.DATA_TYPE = 'enum',
+				IF (col.DATA_TYPE IN ('enum', 'set'),
 						CONCAT(col.TABLE_NAME, '_', col.COLUMN_NAME),
 						col.DATA_TYPE)
 		) AS "dataType.Name",
-		IF (col.DATA_TYPE = 'enum', 'enum', 'base') AS "dataType.Kind",
+		IF (col.DATA_TYPE IN ('enum', 'set'), col.DATA_TYPE, 'base') AS "dataType.Kind",
 		col.COLUMN_TYPE LIKE '%unsigned%' AS "dataType.IsUnsigned"
 FROM INFORMATION_SCHEMA.tables AS t
 INNER JOIN
@@ -59,33 +59,76 @@ ORDER BY
 }
 
 func (m mySqlQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) ([]metadata.Enum, error) {
+	return getColumnTypesMetaData(db, schemaName, metadata.EnumType)
+}
+
+func (m mySqlQuerySet) GetSetsMetaData(db *sql.DB, schemaName string) ([]metadata.Set, error) {
+	return getColumnTypesMetaData(db, schemaName, metadata.SetType)
+}
+
+// getColumnTypesMetaData returns metadata of column-level ENUM or SET types. Types are named after the table and the
+// column (table_column).
+func getColumnTypesMetaData(db *sql.DB, schemaName string, kind metadata.DataTypeKind) ([]metadata.Enum, error) {
 	query := `
-SELECT (CASE c.DATA_TYPE WHEN 'enum' then CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME) ELSE '' END ) as "name", 
-       SUBSTRING(c.COLUMN_TYPE,5) as "values"
+SELECT CONCAT(c.TABLE_NAME, '_', c.COLUMN_NAME) as "name",
+       c.COLUMN_TYPE as "columnType"
 FROM information_schema.columns as c
 	INNER JOIN information_schema.tables  as t on (t.table_schema = c.table_schema AND t.table_name = c.table_name)
-WHERE c.table_schema = ? AND DATA_TYPE = 'enum';
+WHERE c.table_schema = ? AND c.DATA_TYPE = ?
+ORDER BY c.TABLE_NAME, c.ORDINAL_POSITION;
 `
 	var queryResult []struct {
-		Name   string
-		Values string
+		Name       string
+		ColumnType string
 	}
 
-	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &queryResult)
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, kind}, &queryResult)
 	if err != nil {
-		return nil, fmt.Errorf("failed to query enums meta data: %w", err)
+		return nil, fmt.Errorf("failed to query %s meta data: %w", kind, err)
 	}
 
 	var ret []metadata.Enum
 
 	for _, result := range queryResult {
-		enumValues := strings.Replace(result.Values[1:len(result.Values)-1], "'", "", -1)
-
 		ret = append(ret, metadata.Enum{
 			Name:   result.Name,
-			Values: strings.Split(enumValues, ","),
+			Values: parseColumnTypeValues(result.ColumnType),
 		})
 	}
 
 	return ret, nil
 }
+
+// parseColumnTypeValues returns list of values from ENUM or SET column type definition, for instance enum('a','b').
+// Quotes inside the values are escaped by doubling them.
+func parseColumnTypeValues(columnType string) []string {
+	start, end := strings.Index(columnType, "("), strings.LastIndex(columnType, ")")
+	if start < 0 || end < start {
+		return nil
+	}
+
+	var values []string
+	var value strings.Builder
+	quoted := false
+	definition := columnType[start+1 : end]
+
+	for i := 0; i < len(definition); i++ {
+		char := definition[i]
+
+		switch {
+		case char == '\'' && quoted && i+1 < len(definition) && definition[i+1] == '\'':
+			value.WriteByte('\'')
+			i++
+		case char == '\'' && quoted:
+			values = append(values, value.String())
+			value.Reset()
+			quoted = false
+		case char == '\'':
+			quoted = true
+		case quoted:
+			value.WriteByte(char)
+		}
+	}
+
+	return values
+}
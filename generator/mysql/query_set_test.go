@@ -0,0 +1,13 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseColumnTypeValues(t *testing.T) {
+	require.Equal(t, []string{"small", "medium", "large"}, parseColumnTypeValues("enum('small','medium','large')"))
+	require.Equal(t, []string{"it's", "a,b", ""}, parseColumnTypeValues("set('it''s','a,b','')"))
+	require.Nil(t, parseColumnTypeValues("varchar"))
+}
@@ -72,12 +72,16 @@ select
         when tp.typtype = 'd' then 'base'
         when tp.typtype = 'e' then 'enum'
         when tp.typtype = 'r' then 'range'
+        when tp.typtype = 'c' and tp.typnamespace = ns.oid and exists(
+            select 1 from pg_catalog.pg_class as tp_rel where tp_rel.oid = tp.typrelid and tp_rel.relkind = 'c'
+        ) then 'composite'
      end) as "dataType.Kind",
     (case when tp.typtype = 'd' then (select pg_type.typname from pg_catalog.pg_type where pg_type.oid = tp.typbasetype)
           when tp.typcategory = 'A' then pg_catalog.format_type(attr.atttypid, attr.atttypmod)
           else tp.typname
      end) as "dataType.Name",
-    false as "dataType.isUnsigned"
+    false as "dataType.isUnsigned",
+    (case when tp.typtype = 'd' and tp.typnamespace = ns.oid then tp.typname else '' end) as "dataType.DomainName"
 from pg_catalog.pg_attribute as attr
      join pg_catalog.pg_class as cls on cls.oid = attr.attrelid
      join pg_catalog.pg_namespace as ns on ns.oid = cls.relnamespace
@@ -119,3 +123,276 @@ ORDER BY n.nspname, t.typname, e.enumsortorder;`
 
 	return result, nil
 }
+
+func (p postgresQuerySet) GetCompositesMetaData(db *sql.DB, schemaName string) ([]metadata.Composite, error) {
+	query := `
+select
+    tp.typname as "composite.Name",
+    obj_description(tp.oid, 'pg_type') as "composite.Comment"
+from pg_catalog.pg_type as tp
+     join pg_catalog.pg_namespace as ns on ns.oid = tp.typnamespace
+     join pg_catalog.pg_class as cls on cls.oid = tp.typrelid
+where
+    ns.nspname = $1 and
+    tp.typtype = 'c' and
+    cls.relkind = 'c'
+order by
+    tp.typname;
+`
+	var composites []metadata.Composite
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &composites)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query composite types metadata for schema '%s': %w", schemaName, err)
+	}
+
+	for i := range composites {
+		composites[i].Attributes, err = getColumnsMetaData(db, schemaName, composites[i].Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to query composite type attributes metadata: %w", err)
+		}
+	}
+
+	return composites, nil
+}
+
+func (p postgresQuerySet) GetDomainsMetaData(db *sql.DB, schemaName string) ([]metadata.Domain, error) {
+	query := `
+select
+    tp.typname as "domain.Name",
+    obj_description(tp.oid, 'pg_type') as "domain.Comment",
+    (case
+        when base_tp.typtype = 'b' AND base_tp.typcategory = 'A' then 'array'
+        when base_tp.typtype = 'e' then 'enum'
+        when base_tp.typtype = 'r' then 'range'
+        else 'base'
+     end) as "dataType.Kind",
+    (case when base_tp.typcategory = 'A' then pg_catalog.format_type(base_tp.oid, tp.typtypmod)
+          else base_tp.typname
+     end) as "dataType.Name",
+    false as "dataType.isUnsigned",
+    tp.typname as "dataType.DomainName"
+from pg_catalog.pg_type as tp
+     join pg_catalog.pg_namespace as ns on ns.oid = tp.typnamespace
+     join pg_catalog.pg_type as base_tp on base_tp.oid = tp.typbasetype
+where
+    ns.nspname = $1 and
+    tp.typtype = 'd'
+order by
+    tp.typname;
+`
+	var domains []metadata.Domain
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &domains)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query domain types metadata for schema '%s': %w", schemaName, err)
+	}
+
+	return domains, nil
+}
+
+func (p postgresQuerySet) GetRoutinesMetaData(db *sql.DB, schemaName string) ([]metadata.Routine, error) {
+	query := `
+select
+    p.oid::bigint as "pgRoutine.oid",
+    p.proname as "pgRoutine.name",
+    (case when p.prokind = 'p' then 'procedure' else 'function' end) as "pgRoutine.kind",
+    obj_description(p.oid, 'pg_proc') as "pgRoutine.comment",
+    p.proretset as "pgRoutine.returnsSet",
+    coalesce(rel_ns.nspname, '') as "pgRoutine.returnTableSchema",
+    coalesce(rel.relname, '') as "pgRoutine.returnTableName",
+    (case
+        when tp.typtype = 'b' AND tp.typcategory = 'A' then 'array'
+        when tp.typtype = 'e' then 'enum'
+        when tp.typtype = 'r' then 'range'
+        when tp.typtype = 'c' then 'user-defined'
+        else 'base'
+     end) as "dataType.Kind",
+    (case when tp.typtype = 'd' then (select pg_type.typname from pg_catalog.pg_type where pg_type.oid = tp.typbasetype)
+          when tp.typcategory = 'A' then pg_catalog.format_type(tp.oid, null)
+          else tp.typname
+     end) as "dataType.Name",
+    false as "dataType.isUnsigned"
+from pg_catalog.pg_proc as p
+     join pg_catalog.pg_namespace as ns on ns.oid = p.pronamespace
+     join pg_catalog.pg_type as tp on tp.oid = p.prorettype
+     left join pg_catalog.pg_class as rel on rel.oid = tp.typrelid
+     left join pg_catalog.pg_namespace as rel_ns on rel_ns.oid = rel.relnamespace
+where
+    ns.nspname = $1 and
+    p.prokind in ('f', 'p') and
+    -- skip functions installed by extensions
+    not exists(
+        select 1
+        from pg_catalog.pg_depend as dep
+        where dep.classid = 'pg_catalog.pg_proc'::regclass and dep.objid = p.oid and dep.deptype = 'e'
+    ) and
+    -- skip trigger functions and functions returning records without column definitions
+    (tp.typtype <> 'p' or tp.typname = 'void' or (tp.typname = 'record' and p.proallargtypes is not null)) and
+    -- skip polymorphic functions
+    not exists(
+        select 1
+        from unnest(p.proargtypes::oid[]) as arg(type_oid)
+             join pg_catalog.pg_type as arg_tp on arg_tp.oid = arg.type_oid
+        where arg_tp.typtype = 'p'
+    )
+order by
+    p.proname, p.oid;
+`
+	var routines []pgRoutine
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &routines)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query routines metadata for schema '%s': %w", schemaName, err)
+	}
+
+	var ret []metadata.Routine
+
+	for _, routine := range routines {
+		routineMetaData, err := getRoutineMetaData(db, routine)
+		if err != nil {
+			return nil, err
+		}
+
+		ret = append(ret, routineMetaData)
+	}
+
+	return ret, nil
+}
+
+// pgRoutine is pg_proc routine row
+type pgRoutine struct {
+	Oid               int64 `sql:"primary_key"`
+	Name              string
+	Kind              metadata.RoutineKind
+	Comment           string
+	ReturnsSet        bool
+	ReturnTableSchema string
+	ReturnTableName   string
+	DataType          metadata.DataType
+}
+
+// pgRoutineArgument is pg_proc routine argument, with argument mode: i - in, o - out, b - inout, v - variadic and
+// t - table column
+type pgRoutineArgument struct {
+	Position int `sql:"primary_key"`
+	Name     string
+	Mode     string
+	DataType metadata.DataType
+}
+
+func getRoutineMetaData(db *sql.DB, routine pgRoutine) (metadata.Routine, error) {
+	query := `
+select
+    args.position as "pgRoutineArgument.position",
+    coalesce(args.name, '') as "pgRoutineArgument.name",
+    coalesce(args.mode, 'i') as "pgRoutineArgument.mode",
+    (case
+        when tp.typtype = 'b' AND tp.typcategory = 'A' then 'array'
+        when tp.typtype = 'e' then 'enum'
+        when tp.typtype = 'r' then 'range'
+        else 'base'
+     end) as "dataType.Kind",
+    (case when tp.typtype = 'd' then (select pg_type.typname from pg_catalog.pg_type where pg_type.oid = tp.typbasetype)
+          when tp.typcategory = 'A' then pg_catalog.format_type(tp.oid, null)
+          else tp.typname
+     end) as "dataType.Name",
+    false as "dataType.isUnsigned"
+from pg_catalog.pg_proc as p
+     cross join lateral unnest(coalesce(p.proallargtypes, p.proargtypes::oid[]), p.proargmodes::text[], p.proargnames)
+         with ordinality as args(type_oid, mode, name, position)
+     join pg_catalog.pg_type as tp on tp.oid = args.type_oid
+where
+    p.oid = $1
+order by
+    args.position;
+`
+	var arguments []pgRoutineArgument
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{routine.Oid}, &arguments)
+	if err != nil {
+		return metadata.Routine{}, fmt.Errorf("failed to query '%s' routine arguments metadata: %w", routine.Name, err)
+	}
+
+	ret := metadata.Routine{
+		Name:       routine.Name,
+		Kind:       routine.Kind,
+		Comment:    routine.Comment,
+		ReturnsSet: routine.ReturnsSet,
+		ReturnType: routine.DataType,
+	}
+
+	var outColumns []metadata.Column
+
+	for _, argument := range arguments {
+		isInput := argument.Mode == "i" || argument.Mode == "b" || argument.Mode == "v" ||
+			(argument.Mode == "o" && routine.Kind == metadata.ProcedureRoutine) // procedure OUT arguments are passed to CALL
+
+		if isInput {
+			ret.Arguments = append(ret.Arguments, metadata.RoutineArgument{
+				Name:     argument.Name,
+				DataType: argument.DataType,
+			})
+		}
+
+		if argument.Mode == "o" || argument.Mode == "b" || argument.Mode == "t" {
+			columnName := argument.Name
+
+			if columnName == "" {
+				columnName = fmt.Sprintf("column%d", len(outColumns)+1)
+			}
+
+			outColumns = append(outColumns, metadata.Column{
+				Name:       columnName,
+				IsNullable: true,
+				DataType:   argument.DataType,
+			})
+		}
+	}
+
+	if routine.Kind == metadata.ProcedureRoutine {
+		return ret, nil
+	}
+
+	switch {
+	case len(outColumns) == 1 && !routine.ReturnsSet: // function with single OUT argument returns scalar value
+	case len(outColumns) > 0:
+		ret.Columns = outColumns
+	case routine.ReturnTableName != "":
+		ret.Columns, err = getColumnsMetaData(db, routine.ReturnTableSchema, routine.ReturnTableName)
+		if err != nil {
+			return metadata.Routine{}, fmt.Errorf("failed to query '%s' routine result columns: %w", routine.Name, err)
+		}
+	case routine.ReturnsSet:
+		ret.Columns = []metadata.Column{{
+			Name:       routine.Name,
+			IsNullable: true,
+			DataType:   routine.DataType,
+		}}
+	}
+
+	return ret, nil
+}
+
+func (p postgresQuerySet) GetSequencesMetaData(db *sql.DB, schemaName string) ([]metadata.Sequence, error) {
+	query := `
+select
+    cls.relname as "sequence.name",
+    obj_description(cls.oid, 'pg_class') as "sequence.comment"
+from pg_catalog.pg_class as cls
+     join pg_catalog.pg_namespace as ns on ns.oid = cls.relnamespace
+where
+    ns.nspname = $1 and
+    cls.relkind = 'S'
+order by
+    cls.relname;
+`
+	var sequences []metadata.Sequence
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName}, &sequences)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sequences metadata for schema '%s': %w", schemaName, err)
+	}
+
+	return sequences, nil
+}
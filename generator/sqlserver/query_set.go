@@ -0,0 +1,72 @@
+package sqlserver
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// sqlServerQuerySet is dialect query set for SQL Server
+type sqlServerQuerySet struct{}
+
+func (s sqlServerQuerySet) GetTablesMetaData(db *sql.DB, schemaName string, tableType metadata.TableType) ([]metadata.Table, error) {
+	query := `
+SELECT
+		t.TABLE_NAME AS "table.name",
+		col.COLUMN_NAME AS "column.Name",
+		CAST(CASE WHEN col.COLUMN_DEFAULT IS NOT NULL OR sc.is_identity = 1 THEN 1 ELSE 0 END AS BIT) AS "column.HasDefault",
+		CAST(CASE WHEN col.IS_NULLABLE = 'YES' THEN 1 ELSE 0 END AS BIT) AS "column.IsNullable",
+		CAST(CASE WHEN sc.is_computed = 1 THEN 1 ELSE 0 END AS BIT) AS "column.IsGenerated",
+		COALESCE(CAST(ep.value AS NVARCHAR(MAX)), '') AS "column.Comment",
+		CAST(CASE WHEN pk.COLUMN_NAME IS NOT NULL THEN 1 ELSE 0 END AS BIT) AS "column.IsPrimaryKey",
+		CASE
+			WHEN col.DATA_TYPE = 'bit' THEN 'boolean'
+			WHEN col.DATA_TYPE IN ('datetime2', 'smalldatetime', 'datetimeoffset') THEN 'datetime'
+			WHEN col.DATA_TYPE IN ('nchar', 'uniqueidentifier') THEN 'char'
+			WHEN col.DATA_TYPE = 'ntext' THEN 'text'
+			WHEN col.DATA_TYPE IN ('image', 'rowversion', 'timestamp') THEN 'varbinary'
+			WHEN col.DATA_TYPE IN ('money', 'smallmoney') THEN 'decimal'
+			ELSE col.DATA_TYPE
+		END AS "dataType.Name",
+		'base' AS "dataType.Kind",
+		CAST(CASE WHEN col.DATA_TYPE = 'tinyint' THEN 1 ELSE 0 END AS BIT) AS "dataType.IsUnsigned"
+FROM INFORMATION_SCHEMA.TABLES AS t
+		INNER JOIN INFORMATION_SCHEMA.COLUMNS AS col
+				ON t.TABLE_SCHEMA = col.TABLE_SCHEMA AND t.TABLE_NAME = col.TABLE_NAME
+		INNER JOIN sys.columns AS sc
+				ON sc.object_id = OBJECT_ID(QUOTENAME(col.TABLE_SCHEMA) + '.' + QUOTENAME(col.TABLE_NAME))
+				AND sc.name = col.COLUMN_NAME
+		LEFT JOIN sys.extended_properties AS ep
+				ON ep.major_id = sc.object_id AND ep.minor_id = sc.column_id
+				AND ep.class = 1 AND ep.name = 'MS_Description'
+		LEFT JOIN (
+				SELECT kcu.TABLE_SCHEMA, kcu.TABLE_NAME, kcu.COLUMN_NAME
+				FROM INFORMATION_SCHEMA.TABLE_CONSTRAINTS AS tc
+						INNER JOIN INFORMATION_SCHEMA.KEY_COLUMN_USAGE AS kcu
+								ON tc.CONSTRAINT_SCHEMA = kcu.CONSTRAINT_SCHEMA AND tc.CONSTRAINT_NAME = kcu.CONSTRAINT_NAME
+				WHERE tc.CONSTRAINT_TYPE = 'PRIMARY KEY'
+		) AS pk ON pk.TABLE_SCHEMA = col.TABLE_SCHEMA AND pk.TABLE_NAME = col.TABLE_NAME AND pk.COLUMN_NAME = col.COLUMN_NAME
+WHERE t.TABLE_SCHEMA = @p1
+	AND t.TABLE_TYPE = @p2
+ORDER BY
+		t.TABLE_NAME,
+		col.ORDINAL_POSITION;
+`
+
+	var tables []metadata.Table
+
+	_, err := qrm.Query(context.Background(), db, query, []interface{}{schemaName, string(tableType)}, &tables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query column meta data: %w", err)
+	}
+
+	return tables, nil
+}
+
+// GetEnumsMetaData returns empty list, SQL Server does not support enum types
+func (s sqlServerQuerySet) GetEnumsMetaData(db *sql.DB, schemaName string) ([]metadata.Enum, error) {
+	return nil, nil
+}
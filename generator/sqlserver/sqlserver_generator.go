@@ -0,0 +1,58 @@
+package sqlserver
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/generator/template"
+	"github.com/go-jet/jet/v2/sqlserver"
+)
+
+// DefaultSchema is default SQL Server schema
+const DefaultSchema = "dbo"
+
+// GenerateDSN generates jet files for the database schema using dsn connection string. SQL Server driver registered
+// with the "sqlserver" driver name (for instance github.com/microsoft/go-mssqldb) has to be imported by the caller.
+// If schema is empty, DefaultSchema is used.
+func GenerateDSN(dsn, schema, destDir string, templates ...template.Template) error {
+	db, err := sql.Open("sqlserver", dsn)
+	if err != nil {
+		return fmt.Errorf("failed to open sqlserver connection: %w", err)
+	}
+	defer db.Close()
+
+	err = db.Ping()
+	if err != nil {
+		return fmt.Errorf("failed to ping database: %w", err)
+	}
+
+	return GenerateDB(db, schema, destDir, templates...)
+}
+
+// GenerateDB generates jet files for the database schema using already opened database connection.
+// If schema is empty, DefaultSchema is used.
+func GenerateDB(db *sql.DB, schema, destDir string, templates ...template.Template) error {
+	if schema == "" {
+		schema = DefaultSchema
+	}
+
+	fmt.Println("Retrieving schema information...")
+
+	generatorTemplate := template.Default(sqlserver.Dialect)
+	if len(templates) > 0 {
+		generatorTemplate = templates[0]
+	}
+
+	schemaMetadata, err := metadata.GetSchema(db, &sqlServerQuerySet{}, schema)
+	if err != nil {
+		return fmt.Errorf("failed to query database metadata: %w", err)
+	}
+
+	err = template.ProcessSchema(destDir, schemaMetadata, generatorTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process database schema %s: %w", schemaMetadata.Name, err)
+	}
+
+	return nil
+}
@@ -86,7 +86,18 @@ func new{{tableTemplate.TypeName}}Impl(schemaName, tableName, alias string) {{st
 	)
 
 	return {{structImplName}}{
+{{- if and tableTemplate.SoftDeleteColumn (not tableTemplate.VersionColumn)}}
+		Table: {{dialect.PackageName}}.NewSoftDeleteTable(schemaName, tableName, alias, {{columnFieldName tableTemplate.SoftDeleteColumn}}Column, allColumns...),
+{{- else if tableTemplate.VersionColumn}}
+		Table: {{dialect.PackageName}}.NewTableWithOptions(schemaName, tableName, alias, {{dialect.PackageName}}.TableOptions{
+{{- if tableTemplate.SoftDeleteColumn}}
+			SoftDeleteColumn: {{columnFieldName tableTemplate.SoftDeleteColumn}}Column,
+{{- end}}
+			VersionColumn: {{columnFieldName tableTemplate.VersionColumn}}Column,
+		}, allColumns...),
+{{- else}}
 		Table: {{dialect.PackageName}}.NewTable(schemaName, tableName, alias, allColumns...),
+{{- end}}
 
 		//Columns
 {{- range $i, $c := .Columns}}
@@ -133,6 +144,43 @@ type {{$modelTableTemplate.TypeName}} struct {
 
 `
 
+var compositeModelTemplate = `package {{package}}
+
+import (
+	"database/sql/driver"
+
+	"github.com/go-jet/jet/v2/postgres/rowliteral"
+{{- range modelImports}}
+	"{{.}}"
+{{- end}}
+)
+
+{{$compositeTemplate := compositeTemplate}}
+{{golangComment .Comment}}
+type {{$compositeTemplate.TypeName}} struct {
+{{- range .Attributes}}
+{{- $field := structField .}}
+	{{$field.Name}} {{$field.Type.Name}} ` + "{{$field.TagsString}}" + ` {{golangComment .Comment}}
+{{- end}}
+}
+
+// Scan implements sql.Scanner interface for the composite type row literal
+func (c *{{$compositeTemplate.TypeName}}) Scan(value interface{}) error {
+	return rowliteral.Scan(value{{range .Attributes}}, &c.{{(structField .).Name}}{{end}})
+}
+
+// Value implements driver.Valuer interface for the composite type row literal
+func (c {{$compositeTemplate.TypeName}}) Value() (driver.Value, error) {
+	return rowliteral.Value({{range $i, $a := .Attributes}}{{if gt $i 0}}, {{end}}c.{{(structField $a).Name}}{{end}})
+}
+`
+
+var domainModelTemplate = `package {{package}}
+
+{{golangComment .Comment}}
+type {{domainTemplate.TypeName}} {{baseType}}
+`
+
 var enumSQLBuilderTemplate = `package {{package}}
 
 import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
@@ -144,7 +192,7 @@ var {{enumTemplate.InstanceName}} = &struct {
 {{- end}}
 } {
 {{- range $index, $value := .Values}}
-	{{enumValueName $value}}: {{dialect.PackageName}}.NewEnumValue("{{$value}}"),
+	{{enumValueName $value}}: {{dialect.PackageName}}.NewEnumValue({{printf "%q" $value}}),
 {{- end}}
 }
 `
@@ -152,14 +200,17 @@ var {{enumTemplate.InstanceName}} = &struct {
 var enumModelTemplate = `package {{package}}
 {{- $enumTemplate := enumTemplate}}
 
-import "errors"
+import (
+	"database/sql/driver"
+	"errors"
+)
 
 {{golangComment .Comment}}
 type {{$enumTemplate.TypeName}} string
 
 const (
 {{- range $_, $value := .Values}}
-	{{valueName $value}} {{$enumTemplate.TypeName}} = "{{$value}}"
+	{{valueName $value}} {{$enumTemplate.TypeName}} = {{printf "%q" $value}}
 {{- end}}
 )
 
@@ -169,6 +220,24 @@ var {{$enumTemplate.TypeName}}AllValues = []{{$enumTemplate.TypeName}} {
 {{- end}}
 }
 
+// Parse{{$enumTemplate.TypeName}} returns {{$enumTemplate.TypeName}} enum value for the label, or an error if the label is not valid
+func Parse{{$enumTemplate.TypeName}}(label string) ({{$enumTemplate.TypeName}}, error) {
+	switch label {
+{{- range $_, $value := .Values}}
+	case {{printf "%q" $value}}:
+		return {{valueName $value}}, nil
+{{- end}}
+	default:
+		return "", errors.New("jet: invalid value '" + label + "' for {{$enumTemplate.TypeName}} enum")
+	}
+}
+
+// IsValid returns true if e is one of the {{$enumTemplate.TypeName}} enum values
+func (e {{$enumTemplate.TypeName}}) IsValid() bool {
+	_, err := Parse{{$enumTemplate.TypeName}}(string(e))
+	return err == nil
+}
+
 func (e *{{$enumTemplate.TypeName}}) Scan(value interface{}) error {
 	var enumValue string
 	switch val := value.(type) {
@@ -177,23 +246,371 @@ func (e *{{$enumTemplate.TypeName}}) Scan(value interface{}) error {
 	case []byte:
 		enumValue = string(val)
 	default:
-		return errors.New("jet: Invalid scan value for AllTypesEnum enum. Enum value has to be of type string or []byte")
+		return errors.New("jet: Invalid scan value for {{$enumTemplate.TypeName}} enum. Enum value has to be of type string or []byte")
 	}
 
-	switch enumValue {
-{{- range $_, $value := .Values}}
-	case "{{$value}}":
-		*e = {{valueName $value}}
-{{- end}}
-	default:
+	parsedValue, err := Parse{{$enumTemplate.TypeName}}(enumValue)
+	if err != nil {
 		return errors.New("jet: Invalid scan value '" + enumValue + "' for {{$enumTemplate.TypeName}} enum")
 	}
 
+	*e = parsedValue
+	return nil
+}
+
+// Value implements driver.Valuer interface. Invalid enum value returns an error.
+func (e {{$enumTemplate.TypeName}}) Value() (driver.Value, error) {
+	if !e.IsValid() {
+		return nil, errors.New("jet: invalid value '" + string(e) + "' for {{$enumTemplate.TypeName}} enum")
+	}
+
+	return string(e), nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface. Zero value is marshaled to empty text, and other invalid
+// enum values return an error.
+func (e {{$enumTemplate.TypeName}}) MarshalText() ([]byte, error) {
+	if e != "" && !e.IsValid() {
+		return nil, errors.New("jet: invalid value '" + string(e) + "' for {{$enumTemplate.TypeName}} enum")
+	}
+
+	return []byte(e), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface. Empty text is unmarshaled to zero value, and invalid
+// enum label returns an error.
+func (e *{{$enumTemplate.TypeName}}) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*e = ""
+		return nil
+	}
+
+	parsedValue, err := Parse{{$enumTemplate.TypeName}}(string(text))
+	if err != nil {
+		return err
+	}
+
+	*e = parsedValue
 	return nil
 }
 
+// JSONSchema returns JSON schema of the {{$enumTemplate.TypeName}} enum, a string restricted to the enum values
+func ({{$enumTemplate.TypeName}}) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "string",
+		"enum": []string{
+{{- range $_, $value := .Values}}
+			{{printf "%q" $value}},
+{{- end}}
+		},
+	}
+}
+
 func (e {{$enumTemplate.TypeName}}) String() string {
 	return string(e)
 }
 
 `
+
+var setModelTemplate = `package {{package}}
+{{- $setTemplate := setTemplate}}
+
+import (
+	"database/sql/driver"
+	"errors"
+	"strings"
+)
+
+{{golangComment .Comment}}
+type {{$setTemplate.TypeName}} uint64
+
+const (
+{{- range $i, $value := .Values}}
+	{{valueName $value}} {{$setTemplate.TypeName}} = 1 << {{$i}}
+{{- end}}
+)
+
+var {{$setTemplate.TypeName}}AllValues = []{{$setTemplate.TypeName}} {
+{{- range $_, $value := .Values}}
+	{{valueName $value}},
+{{- end}}
+}
+
+// Parse{{$setTemplate.TypeName}} returns {{$setTemplate.TypeName}} set for the comma separated list of set members
+func Parse{{$setTemplate.TypeName}}(value string) ({{$setTemplate.TypeName}}, error) {
+	var ret {{$setTemplate.TypeName}}
+
+	if value == "" {
+		return ret, nil
+	}
+
+	for _, label := range strings.Split(value, ",") {
+		switch label {
+{{- range $_, $value := .Values}}
+		case {{printf "%q" $value}}:
+			ret |= {{valueName $value}}
+{{- end}}
+		default:
+			return 0, errors.New("jet: invalid value '" + label + "' for {{$setTemplate.TypeName}} set")
+		}
+	}
+
+	return ret, nil
+}
+
+// Has returns true if s contains all the members
+func (s {{$setTemplate.TypeName}}) Has(members ...{{$setTemplate.TypeName}}) bool {
+	for _, member := range members {
+		if s&member != member {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Add returns new set with the members added
+func (s {{$setTemplate.TypeName}}) Add(members ...{{$setTemplate.TypeName}}) {{$setTemplate.TypeName}} {
+	for _, member := range members {
+		s |= member
+	}
+
+	return s
+}
+
+// Remove returns new set with the members removed
+func (s {{$setTemplate.TypeName}}) Remove(members ...{{$setTemplate.TypeName}}) {{$setTemplate.TypeName}} {
+	for _, member := range members {
+		s &^= member
+	}
+
+	return s
+}
+
+// IsValid returns true if s contains only {{$setTemplate.TypeName}} set members
+func (s {{$setTemplate.TypeName}}) IsValid() bool {
+	return s.Remove({{$setTemplate.TypeName}}AllValues...) == 0
+}
+
+// Labels returns the labels of the set members, in the set definition order
+func (s {{$setTemplate.TypeName}}) Labels() []string {
+	var labels []string
+{{- range $_, $value := .Values}}
+	if s.Has({{valueName $value}}) {
+		labels = append(labels, {{printf "%q" $value}})
+	}
+{{- end}}
+
+	return labels
+}
+
+func (s *{{$setTemplate.TypeName}}) Scan(value interface{}) error {
+	var setValue string
+	switch val := value.(type) {
+	case string:
+		setValue = val
+	case []byte:
+		setValue = string(val)
+	case int64:
+		bitmask := {{$setTemplate.TypeName}}(val)
+		if !bitmask.IsValid() {
+			return errors.New("jet: Invalid scan value for {{$setTemplate.TypeName}} set")
+		}
+		*s = bitmask
+		return nil
+	default:
+		return errors.New("jet: Invalid scan value for {{$setTemplate.TypeName}} set. Set value has to be of type string, []byte or int64")
+	}
+
+	parsedValue, err := Parse{{$setTemplate.TypeName}}(setValue)
+	if err != nil {
+		return errors.New("jet: Invalid scan value '" + setValue + "' for {{$setTemplate.TypeName}} set")
+	}
+
+	*s = parsedValue
+	return nil
+}
+
+// Value implements driver.Valuer interface. Set containing invalid members returns an error.
+func (s {{$setTemplate.TypeName}}) Value() (driver.Value, error) {
+	if !s.IsValid() {
+		return nil, errors.New("jet: invalid value for {{$setTemplate.TypeName}} set")
+	}
+
+	return s.String(), nil
+}
+
+// MarshalText implements encoding.TextMarshaler interface. Set containing invalid members returns an error.
+func (s {{$setTemplate.TypeName}}) MarshalText() ([]byte, error) {
+	if !s.IsValid() {
+		return nil, errors.New("jet: invalid value for {{$setTemplate.TypeName}} set")
+	}
+
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface. Invalid set member returns an error.
+func (s *{{$setTemplate.TypeName}}) UnmarshalText(text []byte) error {
+	parsedValue, err := Parse{{$setTemplate.TypeName}}(string(text))
+	if err != nil {
+		return err
+	}
+
+	*s = parsedValue
+	return nil
+}
+
+// String returns comma separated list of the set members
+func (s {{$setTemplate.TypeName}}) String() string {
+	return strings.Join(s.Labels(), ",")
+}
+
+`
+
+var routineSQLBuilderTemplate = `
+{{define "argument-params" -}}
+	{{- range $i, $a := .Arguments}}
+		{{- $arg := argument $a $i}}
+		{{- if gt $i 0 }}, {{end}}{{$arg.Name}} {{dialect.PackageName}}.{{$arg.Type}}
+	{{- end}}
+{{- end}}
+
+{{define "argument-list" -}}
+	{{- range $i, $a := .Arguments}}
+		{{- $arg := argument $a $i}}
+		{{- if gt $i 0 }}, {{end}}{{$arg.Name}}
+	{{- end}}
+{{- end}}
+
+package {{package}}
+
+import (
+	"github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+)
+
+{{- $routineTemplate := routineTemplate}}
+
+{{- if .IsProcedure}}
+
+{{golangComment .Comment}}
+func {{$routineTemplate.FuncName}}({{template "argument-params" .}}) {{dialect.PackageName}}.CallStatement {
+	return {{dialect.PackageName}}.CALL({{routineName}}{{if .Arguments}}, {{template "argument-list" .}}{{end}})
+}
+
+{{- else if .IsTableFunction}}
+
+{{golangComment .Comment}}
+func {{$routineTemplate.FuncName}}({{template "argument-params" .}}) *{{$routineTemplate.TypeName}} {
+	return new{{$routineTemplate.TypeName}}(schemaName, "{{.Name}}", "", []{{dialect.PackageName}}.Expression{ {{- template "argument-list" .}} })
+}
+
+type {{$routineTemplate.TypeName}} struct {
+	{{dialect.PackageName}}.TableFunction
+
+	// Columns
+{{- range $i, $c := .Columns}}
+{{- $field := columnField $c}}
+	{{$field.Name}} {{dialect.PackageName}}.Column{{$field.Type}} {{golangComment .Comment}}
+{{- end}}
+
+	AllColumns {{dialect.PackageName}}.ColumnList
+}
+
+// AS creates new {{$routineTemplate.TypeName}} with assigned alias
+func (a {{$routineTemplate.TypeName}}) AS(alias string) *{{$routineTemplate.TypeName}} {
+	return new{{$routineTemplate.TypeName}}(a.SchemaName(), a.TableName(), alias, a.Arguments())
+}
+
+func new{{$routineTemplate.TypeName}}(schemaName, functionName, alias string, arguments []{{dialect.PackageName}}.Expression) *{{$routineTemplate.TypeName}} {
+	var (
+{{- range $i, $c := .Columns}}
+{{- $field := columnField $c}}
+		{{$field.Name}}Column = {{dialect.PackageName}}.{{$field.Type}}Column("{{$c.Name}}")
+{{- end}}
+		allColumns = {{dialect.PackageName}}.ColumnList{
+{{- range $i, $c := .Columns}}
+{{- $field := columnField $c}}
+		{{- if gt $i 0 }}, {{end}}{{$field.Name}}Column
+{{- end}} }
+	)
+
+	return &{{$routineTemplate.TypeName}}{
+		TableFunction: {{dialect.PackageName}}.NewTableFunction(schemaName, functionName, alias, arguments, allColumns...),
+
+		//Columns
+{{- range $i, $c := .Columns}}
+{{- $field := columnField $c}}
+		{{$field.Name}}: {{$field.Name}}Column,
+{{- end}}
+
+		AllColumns: allColumns,
+	}
+}
+
+{{- else}}
+
+{{golangComment .Comment}}
+func {{$routineTemplate.FuncName}}({{template "argument-params" .}}) {{dialect.PackageName}}.{{$routineTemplate.ReturnType}} {
+{{- with expressionWrapper}}
+	return {{dialect.PackageName}}.{{.}}({{dialect.PackageName}}.Func({{routineName}}{{if $.Arguments}}, {{template "argument-list" $}}{{end}}))
+{{- else}}
+	return {{dialect.PackageName}}.Func({{routineName}}{{if .Arguments}}, {{template "argument-list" .}}{{end}})
+{{- end}}
+}
+
+{{- end}}
+`
+
+var routineSqlBuilderSetSchemaTemplate = `package {{package}}
+
+var schemaName = "{{schemaName}}"
+
+// UseSchema sets a new schema name for all generated routine SQL builder types. It is recommended to invoke 
+// this method only once at the beginning of the program.
+func UseSchema(schema string) {
+	schemaName = schema
+}
+`
+
+var sequenceSQLBuilderTemplate = `package {{package}}
+
+import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+
+{{golangComment .Comment}}
+var {{sequenceTemplate.InstanceName}} = {{dialect.PackageName}}.NewSequence("{{schemaName}}", "{{.Name}}")
+`
+
+var sequenceSqlBuilderSetSchemaTemplate = `package {{package}}
+
+// UseSchema sets a new schema name for all generated sequence SQL builder types. It is recommended to invoke 
+// this method only once at the beginning of the program.
+func UseSchema(schema string) {
+{{- range .}}
+	{{ .InstanceName }} = {{ .InstanceName }}.FromSchema(schema)
+{{- end}}
+}
+`
+
+var compositeSQLBuilderTemplate = `package {{package}}
+
+import "github.com/go-jet/jet/v2/{{dialect.PackageName}}"
+
+{{- $compositeTemplate := compositeTemplate}}
+
+{{golangComment .Comment}}
+type {{$compositeTemplate.TypeName}} struct {
+	{{dialect.PackageName}}.Expression
+}
+{{- range .Attributes}}
+{{- $field := compositeField .}}
+
+// {{$field.Name}} returns composite type attribute {{.Name}}
+func (c {{$compositeTemplate.TypeName}}) {{$field.Name}}() {{if not $field.IsComposite}}{{dialect.PackageName}}.{{end}}{{$field.Type}} {
+{{- if $field.IsComposite}}
+	return {{$field.Type}}{Expression: {{dialect.PackageName}}.FIELD[{{dialect.PackageName}}.Expression](c.Expression, "{{.Name}}")}
+{{- else}}
+	return {{dialect.PackageName}}.FIELD[{{dialect.PackageName}}.{{$field.Type}}](c.Expression, "{{.Name}}")
+{{- end}}
+}
+{{- end}}
+`
@@ -30,6 +30,10 @@ type Schema struct {
 	Path       string
 	Model      Model
 	SQLBuilder SQLBuilder
+	// DeduplicateEnums, if set, generates single type for the enums (and sets) with the same list of values. Type is
+	// named after the first such enum. Enums skipped by the model template are not de-duplicated. Useful for MySQL,
+	// where each ENUM and SET column has its own type.
+	DeduplicateEnums bool
 }
 
 // UsePath replaces path and returns new schema template
@@ -50,6 +54,12 @@ func (s Schema) UseSQLBuilder(sqlBuilder SQLBuilder) Schema {
 	return s
 }
 
+// UseDeduplicateEnums returns new schema template with enum and set de-duplication turned on or off
+func (s Schema) UseDeduplicateEnums(deduplicate bool) Schema {
+	s.DeduplicateEnums = deduplicate
+	return s
+}
+
 // DefaultSchema returns default schema template implementation
 func DefaultSchema(schemaMetaData metadata.Schema) Schema {
 	return Schema{
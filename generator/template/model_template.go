@@ -14,11 +14,14 @@ import (
 
 // Model is template for model files generation
 type Model struct {
-	Skip  bool
-	Path  string
-	Table func(table metadata.Table) TableModel
-	View  func(table metadata.Table) ViewModel
-	Enum  func(enum metadata.Enum) EnumModel
+	Skip      bool
+	Path      string
+	Table     func(table metadata.Table) TableModel
+	View      func(table metadata.Table) ViewModel
+	Enum      func(enum metadata.Enum) EnumModel
+	Set       func(set metadata.Set) SetModel
+	Composite func(composite metadata.Composite) CompositeModel
+	Domain    func(domain metadata.Domain) DomainModel
 }
 
 // PackageName returns package name of model types
@@ -50,14 +53,35 @@ func (m Model) UseEnum(enumFunc func(enumMetaData metadata.Enum) EnumModel) Mode
 	return m
 }
 
+// UseSet returns new Model template with replaced template for set model files generation
+func (m Model) UseSet(setFunc func(setMetaData metadata.Set) SetModel) Model {
+	m.Set = setFunc
+	return m
+}
+
+// UseComposite returns new Model template with replaced template for composite type model files generation
+func (m Model) UseComposite(compositeFunc func(composite metadata.Composite) CompositeModel) Model {
+	m.Composite = compositeFunc
+	return m
+}
+
+// UseDomain returns new Model template with replaced template for domain type model files generation
+func (m Model) UseDomain(domainFunc func(domain metadata.Domain) DomainModel) Model {
+	m.Domain = domainFunc
+	return m
+}
+
 // DefaultModel returns default Model template implementation
 func DefaultModel() Model {
 	return Model{
-		Skip:  false,
-		Path:  "/model",
-		Table: DefaultTableModel,
-		View:  DefaultViewModel,
-		Enum:  DefaultEnumModel,
+		Skip:      false,
+		Path:      "/model",
+		Table:     DefaultTableModel,
+		View:      DefaultViewModel,
+		Enum:      DefaultEnumModel,
+		Set:       DefaultSetModel,
+		Composite: DefaultCompositeModel,
+		Domain:    DefaultDomainModel,
 	}
 }
 
@@ -102,14 +126,34 @@ func (t TableModel) UseField(structFieldFunc func(columnMetaData metadata.Column
 	return t
 }
 
+// UseNullableStrategy returns new TableModel with nullable column fields generated using nullable strategy.
+// Previously set TableModelField template function is replaced. It is a shorthand for UseFieldTypes with only
+// Nullable strategy set.
+func (t TableModel) UseNullableStrategy(strategy NullableStrategy) TableModel {
+	t.Field = NullableTableModelField(strategy)
+	return t
+}
+
+// UseFieldTypes returns new TableModel with column fields generated using model field types.
+// Previously set TableModelField template function is replaced.
+func (t TableModel) UseFieldTypes(fieldTypes ModelFieldTypes) TableModel {
+	t.Field = fieldTypes.TableModelField
+	return t
+}
+
 func getTableModelImports(modelType TableModel, tableMetaData metadata.Table) []string {
+	return getModelImports(modelType, tableMetaData.Columns)
+}
+
+func getModelImports(modelType TableModel, columns []metadata.Column) []string {
 	importPaths := map[string]bool{}
-	for _, columnMetaData := range tableMetaData.Columns {
+	for _, columnMetaData := range columns {
 		field := modelType.Field(columnMetaData)
-		importPath := field.Type.ImportPath
 
-		if importPath != "" {
-			importPaths[importPath] = true
+		for _, importPath := range append([]string{field.Type.ImportPath}, field.Type.AdditionalImportPaths...) {
+			if importPath != "" {
+				importPaths[importPath] = true
+			}
 		}
 	}
 
@@ -154,6 +198,114 @@ func DefaultEnumModel(enumMetaData metadata.Enum) EnumModel {
 	}
 }
 
+// SetModel is template for set model files generation. Set is generated as a bitmask of set members.
+type SetModel = EnumModel
+
+// DefaultSetModel returns default implementation for SetModel
+func DefaultSetModel(setMetaData metadata.Set) SetModel {
+	return DefaultEnumModel(setMetaData)
+}
+
+// CompositeModel is template for composite type model files generation. Composite type attributes are generated
+// as struct fields, and the struct implements sql.Scanner and driver.Valuer for the composite row literal format.
+type CompositeModel = TableModel
+
+// DefaultCompositeModel returns default implementation for CompositeModel
+func DefaultCompositeModel(compositeMetaData metadata.Composite) CompositeModel {
+	return CompositeModel{
+		FileName: dbidentifier.ToGoFileName(compositeMetaData.Name),
+		TypeName: dbidentifier.ToGoIdentifier(compositeMetaData.Name),
+		Field:    DefaultTableModelField,
+	}
+}
+
+// DomainModel is template for domain type model files generation
+type DomainModel struct {
+	Skip     bool
+	FileName string
+	TypeName string
+}
+
+// UseFileName returns new DomainModel with new file name set
+func (dm DomainModel) UseFileName(fileName string) DomainModel {
+	dm.FileName = fileName
+	return dm
+}
+
+// UseTypeName returns new DomainModel with new type name set
+func (dm DomainModel) UseTypeName(typeName string) DomainModel {
+	dm.TypeName = typeName
+	return dm
+}
+
+// DefaultDomainModel returns default implementation for DomainModel. Only domains over base types with a Go basic
+// type (string, bool, integers and floats) are generated as distinct named types, other domains are mapped to the
+// Go type of the domain base type.
+func DefaultDomainModel(domainMetaData metadata.Domain) DomainModel {
+	_, ok := getDomainBaseType(domainMetaData.DataType)
+
+	return DomainModel{
+		Skip:     !ok,
+		FileName: dbidentifier.ToGoFileName(domainMetaData.Name),
+		TypeName: dbidentifier.ToGoIdentifier(domainMetaData.Name),
+	}
+}
+
+// domainModels are the templates of the generated domain type models, by domain name
+type domainModels map[string]DomainModel
+
+func newDomainModels(domainsMetaData []metadata.Domain, modelTemplate Model) domainModels {
+	ret := domainModels{}
+
+	if modelTemplate.Domain == nil {
+		return ret
+	}
+
+	for _, domainMetaData := range domainsMetaData {
+		domainTemplate := modelTemplate.Domain(domainMetaData)
+
+		if !domainTemplate.Skip {
+			ret[domainMetaData.Name] = domainTemplate
+		}
+	}
+
+	return ret
+}
+
+// resolveField returns field template function, that uses the domain model type name for the domain columns. Columns
+// of the domains without generated model type have the Go type of the domain base type.
+func (d domainModels) resolveField(fieldFunc func(columnMetaData metadata.Column) TableModelField) func(columnMetaData metadata.Column) TableModelField {
+	return func(columnMetaData metadata.Column) TableModelField {
+		domainName := columnMetaData.DataType.DomainName
+		domainTemplate, generated := d[domainName]
+
+		if !generated {
+			columnMetaData.DataType.DomainName = ""
+			return fieldFunc(columnMetaData)
+		}
+
+		field := fieldFunc(columnMetaData)
+		field.Type.Name = replaceTypeName(field.Type.Name, dbidentifier.ToGoIdentifier(domainName), domainTemplate.TypeName)
+
+		return field
+	}
+}
+
+// replaceTypeName replaces oldName with newName in the model field type name, if the type name is oldName, pointer
+// to oldName or generic null of oldName.
+func replaceTypeName(typeName, oldName, newName string) string {
+	switch typeName {
+	case oldName:
+		return newName
+	case "*" + oldName:
+		return "*" + newName
+	case "qrm.Null[" + oldName + "]":
+		return "qrm.Null[" + newName + "]"
+	}
+
+	return typeName
+}
+
 // TableModelField is template for table model field generation
 type TableModelField struct {
 	Name string
@@ -161,8 +313,56 @@ type TableModelField struct {
 	Tags []string
 }
 
+// NullableStrategy defines Go types of the model fields for the nullable columns
+type NullableStrategy string
+
+// NullableStrategy possible values
+const (
+	// NullablePointer generates nullable column fields as pointers, for instance *string
+	NullablePointer NullableStrategy = "pointer"
+	// NullableSQL generates nullable column fields as database/sql Null types, for instance sql.NullString.
+	// Columns without database/sql Null type equivalent are generated as pointers.
+	NullableSQL NullableStrategy = "sql"
+	// NullableGeneric generates nullable column fields as generic qrm.Null type, for instance qrm.Null[string]
+	NullableGeneric NullableStrategy = "generic"
+	// NullableGuregu generates nullable column fields as gopkg.in/guregu/null.v4 types, for instance null.String.
+	// Columns without guregu null type equivalent are generated as pointers.
+	NullableGuregu NullableStrategy = "guregu"
+)
+
+// DecimalType defines Go types of the model fields for the numeric and decimal columns
+type DecimalType string
+
+// DecimalType possible values
+const (
+	// DecimalFloat generates numeric and decimal column fields as float64. Precision might be lost.
+	DecimalFloat DecimalType = "float"
+	// DecimalShopspring generates numeric and decimal column fields as github.com/shopspring/decimal Decimal type.
+	DecimalShopspring DecimalType = "shopspring"
+	// DecimalJet generates numeric and decimal column fields as qrm.Decimal type.
+	DecimalJet DecimalType = "jet"
+)
+
+// ModelFieldTypes defines Go types of the generated model fields, for nullable and for numeric and decimal columns.
+// Set it with TableModel UseFieldTypes, or use its TableModelField method as TableModelField template function.
+type ModelFieldTypes struct {
+	Nullable NullableStrategy
+	Decimal  DecimalType
+}
+
 // DefaultTableModelField returns default TableModelField implementation
 func DefaultTableModelField(columnMetaData metadata.Column) TableModelField {
+	return ModelFieldTypes{}.TableModelField(columnMetaData)
+}
+
+// NullableTableModelField returns TableModelField implementation with nullable column fields generated
+// using nullable strategy
+func NullableTableModelField(strategy NullableStrategy) func(columnMetaData metadata.Column) TableModelField {
+	return ModelFieldTypes{Nullable: strategy}.TableModelField
+}
+
+// TableModelField returns TableModelField implementation with column fields generated using model field types
+func (m ModelFieldTypes) TableModelField(columnMetaData metadata.Column) TableModelField {
 	var tags []string
 
 	if columnMetaData.IsPrimaryKey {
@@ -171,7 +371,7 @@ func DefaultTableModelField(columnMetaData metadata.Column) TableModelField {
 
 	return TableModelField{
 		Name: dbidentifier.ToGoIdentifier(columnMetaData.Name),
-		Type: getType(columnMetaData),
+		Type: getFieldType(columnMetaData, m),
 		Tags: tags,
 	}
 }
@@ -207,6 +407,8 @@ func (f TableModelField) TagsString() string {
 type Type struct {
 	ImportPath string
 	Name       string
+	// AdditionalImportPaths are import paths of the generic type arguments
+	AdditionalImportPaths []string
 }
 
 // NewType creates new type for dummy object
@@ -235,6 +437,10 @@ func getImportPath(dummyData interface{}) string {
 func getType(columnMetadata metadata.Column) Type {
 	userDefinedType := getUserDefinedType(columnMetadata)
 
+	if _, ok := getDomainBaseType(columnMetadata.DataType); ok {
+		userDefinedType = dbidentifier.ToGoIdentifier(columnMetadata.DataType.DomainName)
+	}
+
 	if userDefinedType != "" {
 		if columnMetadata.IsNullable {
 			return Type{Name: "*" + userDefinedType}
@@ -245,9 +451,117 @@ func getType(columnMetadata metadata.Column) Type {
 	return NewType(getGoType(columnMetadata))
 }
 
+var sqlNullTypes = map[string]string{
+	"string":    "sql.NullString",
+	"bool":      "sql.NullBool",
+	"uint8":     "sql.NullByte",
+	"int16":     "sql.NullInt16",
+	"int32":     "sql.NullInt32",
+	"int64":     "sql.NullInt64",
+	"float64":   "sql.NullFloat64",
+	"time.Time": "sql.NullTime",
+}
+
+var gureguNullTypes = map[string]string{
+	"string":    "null.String",
+	"bool":      "null.Bool",
+	"int8":      "null.Int",
+	"uint8":     "null.Int",
+	"int16":     "null.Int",
+	"uint16":    "null.Int",
+	"int32":     "null.Int",
+	"uint32":    "null.Int",
+	"int64":     "null.Int",
+	"float32":   "null.Float",
+	"float64":   "null.Float",
+	"time.Time": "null.Time",
+}
+
+func getFieldType(columnMetadata metadata.Column, fieldTypes ModelFieldTypes) Type {
+	decimalType, isDecimal := getDecimalType(columnMetadata, fieldTypes.Decimal)
+
+	if !columnMetadata.IsNullable && isDecimal {
+		return decimalType
+	}
+
+	strategy := fieldTypes.Nullable
+
+	if !isDecimal && (!columnMetadata.IsNullable || strategy == NullablePointer || strategy == "") {
+		return getType(columnMetadata)
+	}
+
+	columnMetadata.IsNullable = false
+	valueType := getType(columnMetadata)
+
+	if isDecimal {
+		valueType = decimalType
+	}
+
+	switch strategy {
+	case NullableGeneric:
+		nullType := Type{
+			ImportPath: "github.com/go-jet/jet/v2/qrm",
+			Name:       "qrm.Null[" + valueType.Name + "]",
+		}
+
+		if valueType.ImportPath != "" {
+			nullType.AdditionalImportPaths = []string{valueType.ImportPath}
+		}
+
+		return nullType
+	case NullableSQL:
+		if valueType.Name == "decimal.Decimal" {
+			return Type{ImportPath: valueType.ImportPath, Name: "decimal.NullDecimal"}
+		}
+
+		if nullType, ok := sqlNullTypes[valueType.Name]; ok {
+			return Type{ImportPath: "database/sql", Name: nullType}
+		}
+	case NullableGuregu:
+		if nullType, ok := gureguNullTypes[valueType.Name]; ok {
+			return Type{ImportPath: "gopkg.in/guregu/null.v4", Name: nullType}
+		}
+	}
+
+	return Type{ImportPath: valueType.ImportPath, Name: "*" + valueType.Name}
+}
+
+// getDecimalType returns model field type for numeric and decimal columns, if decimal type is not float
+func getDecimalType(column metadata.Column, decimalType DecimalType) (Type, bool) {
+	if !isDecimalColumn(column) {
+		return Type{}, false
+	}
+
+	if _, ok := getDomainBaseType(column.DataType); ok {
+		return Type{}, false
+	}
+
+	switch decimalType {
+	case DecimalShopspring:
+		return Type{ImportPath: "github.com/shopspring/decimal", Name: "decimal.Decimal"}, true
+	case DecimalJet:
+		return Type{ImportPath: "github.com/go-jet/jet/v2/qrm", Name: "qrm.Decimal"}, true
+	}
+
+	return Type{}, false
+}
+
+func isDecimalColumn(column metadata.Column) bool {
+	if column.DataType.Kind != metadata.BaseType {
+		return false
+	}
+
+	switch strings.ToLower(column.DataType.Name) {
+	case "numeric", "decimal":
+		return true
+	}
+
+	return false
+}
+
 func getUserDefinedType(column metadata.Column) string {
 	switch column.DataType.Kind {
-	case metadata.EnumType:
+	case metadata.EnumType, metadata.SetType, metadata.CompositeType:
 		return dbidentifier.ToGoIdentifier(column.DataType.Name)
 	case metadata.UserDefinedType, metadata.ArrayType:
 		return "string"
@@ -256,6 +570,24 @@ func getUserDefinedType(column metadata.Column) string {
 	return ""
 }
 
+// getDomainBaseType returns Go type name of the domain base type, if the data type is a domain over a base type
+// with a Go basic type.
+func getDomainBaseType(dataType metadata.DataType) (string, bool) {
+	if dataType.DomainName == "" || dataType.Kind != metadata.BaseType {
+		return "", false
+	}
+
+	switch goType := reflect.TypeOf(toGoType(metadata.Column{Name: dataType.DomainName, DataType: dataType})); goType.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return goType.String(), true
+	}
+
+	return "", false
+}
+
 func getGoType(column metadata.Column) interface{} {
 	defaultGoType := toGoType(column)
 
@@ -43,3 +43,63 @@ func Test_TableModelField(t *testing.T) {
 		Tags: nil,
 	})
 }
+
+func Test_NullableTableModelField(t *testing.T) {
+	nullableText := metadata.Column{Name: "title", IsNullable: true, DataType: metadata.DataType{Name: "text", Kind: "base"}}
+	nullableTime := metadata.Column{Name: "created_at", IsNullable: true, DataType: metadata.DataType{Name: "timestamptz", Kind: "base"}}
+	nullableUUID := metadata.Column{Name: "ref", IsNullable: true, DataType: metadata.DataType{Name: "uuid", Kind: "base"}}
+	nonNullableText := metadata.Column{Name: "name", DataType: metadata.DataType{Name: "text", Kind: "base"}}
+
+	testData := []struct {
+		strategy NullableStrategy
+		column   metadata.Column
+		expected Type
+	}{
+		{NullablePointer, nullableText, Type{Name: "*string"}},
+		{NullableSQL, nullableText, Type{ImportPath: "database/sql", Name: "sql.NullString"}},
+		{NullableSQL, nullableTime, Type{ImportPath: "database/sql", Name: "sql.NullTime"}},
+		{NullableSQL, nullableUUID, Type{ImportPath: "github.com/google/uuid", Name: "*uuid.UUID"}},
+		{NullableGeneric, nullableText, Type{ImportPath: "github.com/go-jet/jet/v2/qrm", Name: "qrm.Null[string]"}},
+		{NullableGeneric, nullableUUID, Type{ImportPath: "github.com/go-jet/jet/v2/qrm", Name: "qrm.Null[uuid.UUID]", AdditionalImportPaths: []string{"github.com/google/uuid"}}},
+		{NullableGuregu, nullableTime, Type{ImportPath: "gopkg.in/guregu/null.v4", Name: "null.Time"}},
+		{NullableGuregu, nonNullableText, Type{Name: "string"}},
+	}
+
+	for _, data := range testData {
+		require.Equal(t, data.expected, NullableTableModelField(data.strategy)(data.column).Type)
+		require.Equal(t, data.expected, DefaultTableModel(metadata.Table{}).UseNullableStrategy(data.strategy).Field(data.column).Type)
+	}
+}
+
+func Test_ModelFieldTypesDecimal(t *testing.T) {
+	numeric := metadata.Column{Name: "price", DataType: metadata.DataType{Name: "numeric", Kind: "base"}}
+	nullableDecimal := metadata.Column{Name: "tax", IsNullable: true, DataType: metadata.DataType{Name: "DECIMAL", Kind: "base"}}
+	nullableDouble := metadata.Column{Name: "ratio", IsNullable: true, DataType: metadata.DataType{Name: "double precision", Kind: "base"}}
+	numericDomain := metadata.Column{Name: "amount", DataType: metadata.DataType{Name: "numeric", Kind: "base", DomainName: "money_amount"}}
+
+	shopspring := "github.com/shopspring/decimal"
+	qrm := "github.com/go-jet/jet/v2/qrm"
+
+	testData := []struct {
+		fieldTypes ModelFieldTypes
+		column     metadata.Column
+		expected   Type
+	}{
+		{ModelFieldTypes{}, numeric, Type{Name: "float64"}},
+		{ModelFieldTypes{Decimal: DecimalFloat}, nullableDecimal, Type{Name: "*float64"}},
+		{ModelFieldTypes{Decimal: DecimalShopspring}, numeric, Type{ImportPath: shopspring, Name: "decimal.Decimal"}},
+		{ModelFieldTypes{Decimal: DecimalShopspring}, nullableDecimal, Type{ImportPath: shopspring, Name: "*decimal.Decimal"}},
+		{ModelFieldTypes{Decimal: DecimalShopspring, Nullable: NullableSQL}, nullableDecimal, Type{ImportPath: shopspring, Name: "decimal.NullDecimal"}},
+		{ModelFieldTypes{Decimal: DecimalShopspring, Nullable: NullableGeneric}, nullableDecimal, Type{ImportPath: qrm, Name: "qrm.Null[decimal.Decimal]", AdditionalImportPaths: []string{shopspring}}},
+		{ModelFieldTypes{Decimal: DecimalShopspring, Nullable: NullableGuregu}, nullableDecimal, Type{ImportPath: shopspring, Name: "*decimal.Decimal"}},
+		{ModelFieldTypes{Decimal: DecimalJet}, numeric, Type{ImportPath: qrm, Name: "qrm.Decimal"}},
+		{ModelFieldTypes{Decimal: DecimalJet}, nullableDecimal, Type{ImportPath: qrm, Name: "*qrm.Decimal"}},
+		{ModelFieldTypes{Decimal: DecimalJet, Nullable: NullableGeneric}, nullableDecimal, Type{ImportPath: qrm, Name: "qrm.Null[qrm.Decimal]", AdditionalImportPaths: []string{qrm}}},
+		{ModelFieldTypes{Decimal: DecimalJet}, nullableDouble, Type{Name: "*float64"}},
+		{ModelFieldTypes{Decimal: DecimalJet}, numericDomain, Type{Name: "MoneyAmount"}},
+	}
+
+	for _, data := range testData {
+		require.Equal(t, data.expected, data.fieldTypes.TableModelField(data.column).Type, data.column.Name)
+	}
+}
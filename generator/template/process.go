@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/utils/filesys"
 	"path"
+	"strconv"
 	"strings"
 	"text/template"
 
@@ -22,6 +23,10 @@ func ProcessSchema(dirPath string, schemaMetaData metadata.Schema, generatorTemp
 	schemaTemplate := generatorTemplate.Schema(schemaMetaData)
 	schemaPath := path.Join(dirPath, schemaTemplate.Path)
 
+	if schemaTemplate.DeduplicateEnums {
+		schemaMetaData = deduplicateEnums(schemaMetaData, schemaTemplate.Model)
+	}
+
 	fmt.Println("Destination directory:", schemaPath)
 	fmt.Println("Cleaning up destination directory...")
 	err := filesys.RemoveDir(schemaPath)
@@ -42,6 +47,69 @@ func ProcessSchema(dirPath string, schemaMetaData metadata.Schema, generatorTemp
 	return nil
 }
 
+// deduplicateEnums removes enums and sets with the same list of values as some previous not skipped enum or set, and
+// changes the type of the columns of removed types to the type of the previous one.
+func deduplicateEnums(schemaMetaData metadata.Schema, modelTemplate Model) metadata.Schema {
+	typeNames := map[string]string{}
+
+	deduplicate := func(enums []metadata.Enum, isSkipped func(metadata.Enum) bool) []metadata.Enum {
+		var ret []metadata.Enum
+		typeNamesByValues := map[string]string{}
+
+		for _, enum := range enums {
+			valuesKey := strings.Join(enum.Values, "\x00")
+
+			if typeName, ok := typeNamesByValues[valuesKey]; ok && !isSkipped(enum) {
+				typeNames[enum.Name] = typeName
+				continue
+			}
+
+			if !isSkipped(enum) {
+				typeNamesByValues[valuesKey] = enum.Name
+			}
+
+			ret = append(ret, enum)
+		}
+
+		return ret
+	}
+
+	schemaMetaData.EnumsMetaData = deduplicate(schemaMetaData.EnumsMetaData, func(enum metadata.Enum) bool {
+		return modelTemplate.Enum != nil && modelTemplate.Enum(enum).Skip
+	})
+	schemaMetaData.SetsMetaData = deduplicate(schemaMetaData.SetsMetaData, func(set metadata.Set) bool {
+		return modelTemplate.Set != nil && modelTemplate.Set(set).Skip
+	})
+
+	renameColumnTypes := func(tables []metadata.Table) []metadata.Table {
+		var ret []metadata.Table
+
+		for _, table := range tables {
+			columns := make([]metadata.Column, len(table.Columns))
+
+			for i, column := range table.Columns {
+				isEnumOrSet := column.DataType.Kind == metadata.EnumType || column.DataType.Kind == metadata.SetType
+
+				if typeName, ok := typeNames[column.DataType.Name]; ok && isEnumOrSet {
+					column.DataType.Name = typeName
+				}
+
+				columns[i] = column
+			}
+
+			table.Columns = columns
+			ret = append(ret, table)
+		}
+
+		return ret
+	}
+
+	schemaMetaData.TablesMetaData = renameColumnTypes(schemaMetaData.TablesMetaData)
+	schemaMetaData.ViewsMetaData = renameColumnTypes(schemaMetaData.ViewsMetaData)
+
+	return schemaMetaData
+}
+
 func processModel(dirPath string, schemaMetaData metadata.Schema, schemaTemplate Schema) error {
 	modelTemplate := schemaTemplate.Model
 
@@ -57,12 +125,14 @@ func processModel(dirPath string, schemaMetaData metadata.Schema, schemaTemplate
 		return fmt.Errorf("destination dir path does not exist: %w", err)
 	}
 
-	err = processTableModels("table", modelDirPath, schemaMetaData.TablesMetaData, modelTemplate)
+	domains := newDomainModels(schemaMetaData.DomainsMetaData, modelTemplate)
+
+	err = processTableModels("table", modelDirPath, schemaMetaData.TablesMetaData, modelTemplate, domains)
 	if err != nil {
 		return fmt.Errorf("failed to generate table model types: %w", err)
 	}
 
-	err = processTableModels("view", modelDirPath, schemaMetaData.ViewsMetaData, modelTemplate)
+	err = processTableModels("view", modelDirPath, schemaMetaData.ViewsMetaData, modelTemplate, domains)
 	if err != nil {
 		return fmt.Errorf("failed to generate view model types: %w", err)
 	}
@@ -72,6 +142,21 @@ func processModel(dirPath string, schemaMetaData metadata.Schema, schemaTemplate
 		return fmt.Errorf("failed to process enum types: %w", err)
 	}
 
+	err = processSetModels(modelDirPath, schemaMetaData.SetsMetaData, modelTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process set types: %w", err)
+	}
+
+	err = processCompositeModels(modelDirPath, schemaMetaData.CompositesMetaData, modelTemplate, domains)
+	if err != nil {
+		return fmt.Errorf("failed to process composite types: %w", err)
+	}
+
+	err = processDomainModels(modelDirPath, schemaMetaData.DomainsMetaData, modelTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process domain types: %w", err)
+	}
+
 	return nil
 }
 
@@ -95,23 +180,275 @@ func processSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metad
 		return fmt.Errorf("failed to process view sql builder types: %w", err)
 	}
 
-	err = processEnumSQLBuilder(sqlBuilderPath, dialect, schemaMetaData.EnumsMetaData, sqlBuilderTemplate)
+	err = processEnumSQLBuilder("enum", sqlBuilderPath, dialect, schemaMetaData.EnumsMetaData, sqlBuilderTemplate.Enum)
 	if err != nil {
 		return fmt.Errorf("failed to process enum types: %w", err)
 	}
 
+	err = processEnumSQLBuilder("set", sqlBuilderPath, dialect, schemaMetaData.SetsMetaData, sqlBuilderTemplate.Set)
+	if err != nil {
+		return fmt.Errorf("failed to process set types: %w", err)
+	}
+
+	err = processRoutineSQLBuilder(sqlBuilderPath, dialect, schemaMetaData, sqlBuilderTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process routine types: %w", err)
+	}
+
+	err = processSequenceSQLBuilder(sqlBuilderPath, dialect, schemaMetaData, sqlBuilderTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process sequence types: %w", err)
+	}
+
+	err = processCompositeSQLBuilder(sqlBuilderPath, dialect, schemaMetaData.CompositesMetaData, sqlBuilderTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to process composite types: %w", err)
+	}
+
 	return nil
 }
 
-func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []metadata.Enum, sqlBuilder SQLBuilder) error {
-	if len(enumsMetaData) == 0 {
+func processCompositeSQLBuilder(dirPath string, dialect jet.Dialect, compositesMetaData []metadata.Composite, sqlBuilder SQLBuilder) error {
+	if len(compositesMetaData) == 0 || sqlBuilder.Composite == nil {
+		return nil
+	}
+
+	fmt.Printf("Generating composite type sql builder files\n")
+
+	for _, compositeMetaData := range compositesMetaData {
+		compositeTemplate := sqlBuilder.Composite(compositeMetaData)
+
+		if compositeTemplate.Skip {
+			continue
+		}
+
+		compositeSQLBuilderPath := path.Join(dirPath, compositeTemplate.Path)
+
+		err := filesys.EnsureDirPathExist(compositeSQLBuilderPath)
+		if err != nil {
+			return fmt.Errorf("failed to create composite sql builder directory - %s: %w", compositeSQLBuilderPath, err)
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+compositeSQLBuilderTemplate,
+			compositeMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return compositeTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"compositeTemplate": func() CompositeSQLBuilder {
+					return compositeTemplate
+				},
+				"compositeField": func(attribute metadata.Column) CompositeSQLBuilderField {
+					return compositeTemplate.Field(attribute)
+				},
+				"golangComment": formatGolangComment,
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate composite sql builder type %s: %w", compositeTemplate.FileName, err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(compositeSQLBuilderPath, compositeTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to format and save '%s' composite type: %w", compositeTemplate.FileName, err)
+		}
+	}
+
+	return nil
+}
+
+func processSequenceSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, sqlBuilder SQLBuilder) error {
+	if len(schemaMetaData.SequencesMetaData) == 0 || sqlBuilder.Sequence == nil {
+		return nil
+	}
+
+	fmt.Printf("Generating sequence sql builder files\n")
+
+	generatedSequences := map[string][]SequenceSQLBuilder{} // sequence sql builder path -> generated sequences
+
+	for _, sequenceMetaData := range schemaMetaData.SequencesMetaData {
+		sequenceTemplate := sqlBuilder.Sequence(sequenceMetaData)
+
+		if sequenceTemplate.Skip {
+			continue
+		}
+
+		sequenceSQLBuilderPath := path.Join(dirPath, sequenceTemplate.Path)
+
+		err := filesys.EnsureDirPathExist(sequenceSQLBuilderPath)
+		if err != nil {
+			return fmt.Errorf("failed to create sequence sql builder directory - %s: %w", sequenceSQLBuilderPath, err)
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+sequenceSQLBuilderTemplate,
+			sequenceMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return sequenceTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"schemaName": func() string {
+					return schemaMetaData.Name
+				},
+				"sequenceTemplate": func() SequenceSQLBuilder {
+					return sequenceTemplate
+				},
+				"golangComment": formatGolangComment,
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate sequence type %s: %w", sequenceTemplate.FileName, err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(sequenceSQLBuilderPath, sequenceTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to format and save '%s' sequence type: %w", sequenceTemplate.FileName, err)
+		}
+
+		generatedSequences[sequenceSQLBuilderPath] = append(generatedSequences[sequenceSQLBuilderPath], sequenceTemplate)
+	}
+
+	for sequencePath, sequences := range generatedSequences {
+		text, err := generateTemplate(
+			autoGenWarningTemplate+sequenceSqlBuilderSetSchemaTemplate,
+			sequences,
+			template.FuncMap{
+				"package": func() string { return sequences[0].PackageName() },
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate sequence use schema template: %w", err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(sequencePath, "sequence_use_schema", text)
+		if err != nil {
+			return fmt.Errorf("failed to save sequence_use_schema file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func processRoutineSQLBuilder(dirPath string, dialect jet.Dialect, schemaMetaData metadata.Schema, sqlBuilder SQLBuilder) error {
+	if len(schemaMetaData.RoutinesMetaData) == 0 || sqlBuilder.Routine == nil {
 		return nil
 	}
 
-	fmt.Printf("Generating enum sql builder files\n")
+	fmt.Printf("Generating routine sql builder files\n")
+
+	generatedFiles := map[string]bool{}
+	routinePaths := map[string]string{} // routine sql builder path -> package name
+
+	for _, routineMetaData := range schemaMetaData.RoutinesMetaData {
+		routineTemplate := sqlBuilder.Routine(routineMetaData)
+
+		if routineTemplate.Skip {
+			continue
+		}
+
+		routineSQLBuilderPath := path.Join(dirPath, routineTemplate.Path)
+		routineFilePath := path.Join(routineSQLBuilderPath, routineTemplate.FileName)
+
+		if generatedFiles[routineFilePath] {
+			fmt.Println("- [SQL Builder] Skipping overloaded routine '" + routineMetaData.Name + "', " +
+				"routine with the same name is already generated.")
+			continue
+		}
+
+		err := filesys.EnsureDirPathExist(routineSQLBuilderPath)
+		if err != nil {
+			return fmt.Errorf("failed to create routine sql builder directory - %s: %w", routineSQLBuilderPath, err)
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+routineSQLBuilderTemplate,
+			routineMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return routineTemplate.PackageName()
+				},
+				"dialect": func() jet.Dialect {
+					return dialect
+				},
+				"routineTemplate": func() RoutineSQLBuilder {
+					return routineTemplate
+				},
+				"routineName": func() string {
+					return "schemaName + " + strconv.Quote("."+routineIdentifier(dialect, routineMetaData.Name))
+				},
+				"argument": func(argument metadata.RoutineArgument, index int) RoutineSQLBuilderArgument {
+					return routineTemplate.Argument(argument, index)
+				},
+				"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
+					return routineTemplate.Column(columnMetaData)
+				},
+				"expressionWrapper": func() string {
+					return expressionWrappers[routineTemplate.ReturnType]
+				},
+				"golangComment": formatGolangComment,
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate routine sql builder type %s: %w", routineTemplate.FileName, err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(routineSQLBuilderPath, routineTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to format and save '%s' routine type: %w", routineTemplate.FileName, err)
+		}
+
+		generatedFiles[routineFilePath] = true
+		routinePaths[routineSQLBuilderPath] = routineTemplate.PackageName()
+	}
+
+	for routinePath, packageName := range routinePaths {
+		text, err := generateTemplate(
+			autoGenWarningTemplate+routineSqlBuilderSetSchemaTemplate,
+			nil,
+			template.FuncMap{
+				"package":    func() string { return packageName },
+				"schemaName": func() string { return schemaMetaData.Name },
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("failed to generate routine use schema template: %w", err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(routinePath, "routine_use_schema", text)
+		if err != nil {
+			return fmt.Errorf("failed to save routine_use_schema file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// routineIdentifier quotes routine name, if the name would not be preserved by the database unquoted
+func routineIdentifier(dialect jet.Dialect, name string) string {
+	if strings.ToLower(name) == name && !strings.ContainsAny(name, " .-\"") && !dialect.IsReservedWord(name) {
+		return name
+	}
+
+	return string(dialect.IdentifierQuoteChar()) + name + string(dialect.IdentifierCloseQuoteChar())
+}
+
+func processEnumSQLBuilder(fileTypes, dirPath string,
+	dialect jet.Dialect,
+	enumsMetaData []metadata.Enum,
+	enumTemplateFunc func(enum metadata.Enum) EnumSQLBuilder) error {
+
+	if len(enumsMetaData) == 0 || enumTemplateFunc == nil {
+		return nil
+	}
+
+	fmt.Printf("Generating %s sql builder files\n", fileTypes)
 
 	for _, enumMetaData := range enumsMetaData {
-		enumTemplate := sqlBuilder.Enum(enumMetaData)
+		enumTemplate := enumTemplateFunc(enumMetaData)
 
 		if enumTemplate.Skip {
 			continue
@@ -143,12 +480,12 @@ func processEnumSQLBuilder(dirPath string, dialect jet.Dialect, enumsMetaData []
 				"golangComment": formatGolangComment,
 			})
 		if err != nil {
-			return fmt.Errorf("failed to generete enum type %s: %w", enumTemplate.FileName, err)
+			return fmt.Errorf("failed to generete %s type %s: %w", fileTypes, enumTemplate.FileName, err)
 		}
 
 		err = filesys.FormatAndSaveGoFile(enumSQLBuilderPath, enumTemplate.FileName, text)
 		if err != nil {
-			return fmt.Errorf("failed to format and save '%s' enum type : %w", enumTemplate.FileName, err)
+			return fmt.Errorf("failed to format and save '%s' %s type : %w", enumTemplate.FileName, fileTypes, err)
 		}
 	}
 
@@ -212,6 +549,15 @@ func processTableSQLBuilder(fileTypes, dirPath string,
 				"columnField": func(columnMetaData metadata.Column) TableSQLBuilderColumn {
 					return tableSQLBuilder.Column(columnMetaData)
 				},
+				"columnFieldName": func(columnName string) (string, error) {
+					for _, column := range tableMetaData.Columns {
+						if column.Name == columnName {
+							return tableSQLBuilder.Column(column).Name, nil
+						}
+					}
+
+					return "", fmt.Errorf("column '%s' not found", columnName)
+				},
 				"toUpper": strings.ToUpper,
 				"insertedRowAlias": func() string {
 					return insertedRowAlias(dialect)
@@ -274,7 +620,7 @@ func insertedRowAlias(dialect jet.Dialect) string {
 	return "excluded"
 }
 
-func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadata.Table, modelTemplate Model) error {
+func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadata.Table, modelTemplate Model, domains domainModels) error {
 	if len(tablesMetaData) == 0 {
 		return nil
 	}
@@ -293,6 +639,8 @@ func processTableModels(fileTypes, modelDirPath string, tablesMetaData []metadat
 			continue
 		}
 
+		tableTemplate.Field = domains.resolveField(tableTemplate.Field)
+
 		text, err := generateTemplate(
 			autoGenWarningTemplate+tableModelFileTemplate,
 			tableMetaData,
@@ -366,6 +714,139 @@ func processEnumModels(modelDir string, enumsMetaData []metadata.Enum, modelTemp
 	return nil
 }
 
+func processSetModels(modelDir string, setsMetaData []metadata.Set, modelTemplate Model) error {
+	if len(setsMetaData) == 0 || modelTemplate.Set == nil {
+		return nil
+	}
+	fmt.Print("Generating set model files...\n")
+
+	for _, setMetaData := range setsMetaData {
+		setTemplate := modelTemplate.Set(setMetaData)
+
+		if setTemplate.Skip {
+			continue
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+setModelTemplate,
+			setMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return modelTemplate.PackageName()
+				},
+				"setTemplate": func() SetModel {
+					return setTemplate
+				},
+				"valueName": func(value string) string {
+					return setTemplate.ValueName(value)
+				},
+				"golangComment": formatGolangComment,
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate set type '%s': %w", setMetaData.Name, err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(modelDir, setTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to save '%s' set type: %w", setTemplate.FileName, err)
+		}
+	}
+
+	return nil
+}
+
+func processCompositeModels(modelDir string, compositesMetaData []metadata.Composite, modelTemplate Model, domains domainModels) error {
+	if len(compositesMetaData) == 0 || modelTemplate.Composite == nil {
+		return nil
+	}
+	fmt.Print("Generating composite type model files...\n")
+
+	for _, compositeMetaData := range compositesMetaData {
+		compositeTemplate := modelTemplate.Composite(compositeMetaData)
+
+		if compositeTemplate.Skip {
+			continue
+		}
+
+		compositeTemplate.Field = domains.resolveField(compositeTemplate.Field)
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+compositeModelTemplate,
+			compositeMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return modelTemplate.PackageName()
+				},
+				"modelImports": func() []string {
+					return getModelImports(compositeTemplate, compositeMetaData.Attributes)
+				},
+				"compositeTemplate": func() CompositeModel {
+					return compositeTemplate
+				},
+				"structField": func(columnMetaData metadata.Column) TableModelField {
+					return compositeTemplate.Field(columnMetaData)
+				},
+				"golangComment": formatGolangComment,
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate composite type '%s': %w", compositeMetaData.Name, err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(modelDir, compositeTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to save '%s' composite type: %w", compositeTemplate.FileName, err)
+		}
+	}
+
+	return nil
+}
+
+func processDomainModels(modelDir string, domainsMetaData []metadata.Domain, modelTemplate Model) error {
+	if len(domainsMetaData) == 0 || modelTemplate.Domain == nil {
+		return nil
+	}
+	fmt.Print("Generating domain type model files...\n")
+
+	for _, domainMetaData := range domainsMetaData {
+		domainTemplate := modelTemplate.Domain(domainMetaData)
+
+		if domainTemplate.Skip {
+			continue
+		}
+
+		baseType, ok := getDomainBaseType(domainMetaData.DataType)
+		if !ok {
+			baseType = "string"
+		}
+
+		text, err := generateTemplate(
+			autoGenWarningTemplate+domainModelTemplate,
+			domainMetaData,
+			template.FuncMap{
+				"package": func() string {
+					return modelTemplate.PackageName()
+				},
+				"domainTemplate": func() DomainModel {
+					return domainTemplate
+				},
+				"baseType": func() string {
+					return baseType
+				},
+				"golangComment": formatGolangComment,
+			})
+		if err != nil {
+			return fmt.Errorf("failed to generate domain type '%s': %w", domainMetaData.Name, err)
+		}
+
+		err = filesys.FormatAndSaveGoFile(modelDir, domainTemplate.FileName, text)
+		if err != nil {
+			return fmt.Errorf("failed to save '%s' domain type: %w", domainTemplate.FileName, err)
+		}
+	}
+
+	return nil
+}
+
 func generateTemplate(templateText string, templateData interface{}, funcMap template.FuncMap) ([]byte, error) {
 	t, err := template.New("sqlBuilderTableTemplate").Funcs(funcMap).Parse(templateText)
 
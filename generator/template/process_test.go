@@ -0,0 +1,490 @@
+package template
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/go-jet/jet/v2/generator/metadata"
+	"github.com/go-jet/jet/v2/mysql"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	intType    = metadata.DataType{Name: "int4", Kind: metadata.BaseType}
+	textType   = metadata.DataType{Name: "text", Kind: metadata.BaseType}
+	floatType  = metadata.DataType{Name: "numeric", Kind: metadata.BaseType}
+	voidType   = metadata.DataType{Name: "void", Kind: metadata.BaseType}
+	recordType = metadata.DataType{Name: "record", Kind: metadata.BaseType}
+)
+
+var routinesSchema = metadata.Schema{
+	Name: "public",
+	RoutinesMetaData: []metadata.Routine{
+		{
+			Name:       "order_total",
+			Kind:       metadata.FunctionRoutine,
+			Comment:    "Order total with discount",
+			ReturnType: floatType,
+			Arguments: []metadata.RoutineArgument{
+				{Name: "order_id", DataType: intType},
+				{Name: "type", DataType: textType},
+			},
+		},
+		{
+			Name:       "order_total",
+			Kind:       metadata.FunctionRoutine,
+			ReturnType: floatType,
+		},
+		{
+			Name:       "refresh_stats",
+			Kind:       metadata.FunctionRoutine,
+			ReturnType: voidType,
+		},
+		{
+			Name:       "customer_orders",
+			Kind:       metadata.FunctionRoutine,
+			ReturnsSet: true,
+			ReturnType: recordType,
+			Arguments: []metadata.RoutineArgument{
+				{DataType: intType},
+			},
+			Columns: []metadata.Column{
+				{Name: "order_id", DataType: intType, IsNullable: true},
+				{Name: "total", DataType: floatType, IsNullable: true},
+			},
+		},
+		{
+			Name: "ShipOrder",
+			Kind: metadata.ProcedureRoutine,
+			Arguments: []metadata.RoutineArgument{
+				{Name: "order_id", DataType: intType},
+			},
+		},
+	},
+}
+
+func TestProcessRoutineSQLBuilder(t *testing.T) {
+	dirPath := t.TempDir()
+
+	err := processRoutineSQLBuilder(dirPath, postgres.Dialect, routinesSchema, DefaultSQLBuilder())
+	require.NoError(t, err)
+
+	routinePath := path.Join(dirPath, "routine")
+
+	requireFileContains(t, path.Join(routinePath, "order_total.go"), `
+// Order total with discount
+func OrderTotal(orderID postgres.IntegerExpression, typeArg postgres.StringExpression) postgres.FloatExpression {
+	return postgres.FloatExp(postgres.Func(schemaName+".order_total", orderID, typeArg))
+}
+`)
+	requireFileContains(t, path.Join(routinePath, "refresh_stats.go"), `
+func RefreshStats() postgres.Expression {
+	return postgres.Func(schemaName + ".refresh_stats")
+}
+`)
+	requireFileContains(t, path.Join(routinePath, "customer_orders.go"), `
+func CustomerOrders(arg1 postgres.IntegerExpression) *CustomerOrdersTable {
+	return newCustomerOrdersTable(schemaName, "customer_orders", "", []postgres.Expression{arg1})
+}
+
+type CustomerOrdersTable struct {
+	postgres.TableFunction
+
+	// Columns
+	OrderID postgres.ColumnInteger
+	Total   postgres.ColumnFloat
+
+	AllColumns postgres.ColumnList
+}
+
+// AS creates new CustomerOrdersTable with assigned alias
+func (a CustomerOrdersTable) AS(alias string) *CustomerOrdersTable {
+	return newCustomerOrdersTable(a.SchemaName(), a.TableName(), alias, a.Arguments())
+}
+
+func newCustomerOrdersTable(schemaName, functionName, alias string, arguments []postgres.Expression) *CustomerOrdersTable {
+	var (
+		OrderIDColumn = postgres.IntegerColumn("order_id")
+		TotalColumn   = postgres.FloatColumn("total")
+		allColumns    = postgres.ColumnList{OrderIDColumn, TotalColumn}
+	)
+
+	return &CustomerOrdersTable{
+		TableFunction: postgres.NewTableFunction(schemaName, functionName, alias, arguments, allColumns...),
+
+		//Columns
+		OrderID: OrderIDColumn,
+		Total:   TotalColumn,
+
+		AllColumns: allColumns,
+	}
+}
+`)
+	requireFileContains(t, path.Join(routinePath, "shiporder.go"), `
+func ShipOrder(orderID postgres.IntegerExpression) postgres.CallStatement {
+	return postgres.CALL(schemaName+".\"ShipOrder\"", orderID)
+}
+`)
+	requireFileContains(t, path.Join(routinePath, "routine_use_schema.go"), `
+var schemaName = "public"
+`)
+}
+
+func TestProcessRoutineSQLBuilderSkip(t *testing.T) {
+	dirPath := t.TempDir()
+
+	sqlBuilder := DefaultSQLBuilder().UseRoutine(func(routine metadata.Routine) RoutineSQLBuilder {
+		return DefaultRoutineSQLBuilder(routine).UsePath("/function")
+	})
+
+	err := processRoutineSQLBuilder(dirPath, postgres.Dialect, routinesSchema, sqlBuilder)
+	require.NoError(t, err)
+	require.FileExists(t, path.Join(dirPath, "function", "order_total.go"))
+
+	dirPath = t.TempDir()
+	sqlBuilder.Routine = nil
+
+	err = processRoutineSQLBuilder(dirPath, postgres.Dialect, routinesSchema, sqlBuilder)
+	require.NoError(t, err)
+	require.NoDirExists(t, path.Join(dirPath, "routine"))
+}
+
+func TestProcessSequenceSQLBuilder(t *testing.T) {
+	dirPath := t.TempDir()
+
+	schema := metadata.Schema{
+		Name: "public",
+		SequencesMetaData: []metadata.Sequence{
+			{Name: "order_number_seq", Comment: "Order numbers"},
+			{Name: "invoice_seq"},
+		},
+	}
+
+	err := processSequenceSQLBuilder(dirPath, postgres.Dialect, schema, DefaultSQLBuilder())
+	require.NoError(t, err)
+
+	sequencePath := path.Join(dirPath, "sequence")
+
+	requireFileContains(t, path.Join(sequencePath, "order_number_seq.go"), `
+// Order numbers
+var OrderNumberSeq = postgres.NewSequence("public", "order_number_seq")
+`)
+	requireFileContains(t, path.Join(sequencePath, "invoice_seq.go"), `
+var InvoiceSeq = postgres.NewSequence("public", "invoice_seq")
+`)
+	requireFileContains(t, path.Join(sequencePath, "sequence_use_schema.go"), `
+func UseSchema(schema string) {
+	OrderNumberSeq = OrderNumberSeq.FromSchema(schema)
+	InvoiceSeq = InvoiceSeq.FromSchema(schema)
+}
+`)
+}
+
+var userDefinedTypesSchema = metadata.Schema{
+	Name: "public",
+	TablesMetaData: []metadata.Table{
+		{
+			Name: "customer",
+			Columns: []metadata.Column{
+				{Name: "id", IsPrimaryKey: true, DataType: intType},
+				{Name: "email", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType, DomainName: "email"}},
+				{Name: "home_address", IsNullable: true, DataType: metadata.DataType{Name: "address", Kind: metadata.CompositeType}},
+			},
+		},
+	},
+	CompositesMetaData: []metadata.Composite{
+		{
+			Name:    "address",
+			Comment: "Postal address",
+			Attributes: []metadata.Column{
+				{Name: "street", IsNullable: true, DataType: textType},
+				{Name: "zip", IsNullable: true, DataType: intType},
+				{Name: "location", IsNullable: true, DataType: metadata.DataType{Name: "geo_point", Kind: metadata.CompositeType}},
+			},
+		},
+	},
+	DomainsMetaData: []metadata.Domain{
+		{Name: "email", Comment: "Email address", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType, DomainName: "email"}},
+		{Name: "uid", DataType: metadata.DataType{Name: "uuid", Kind: metadata.BaseType, DomainName: "uid"}},
+	},
+}
+
+func TestProcessUserDefinedTypeModels(t *testing.T) {
+	dirPath := t.TempDir()
+
+	err := processModel(dirPath, userDefinedTypesSchema, DefaultSchema(userDefinedTypesSchema))
+	require.NoError(t, err)
+
+	modelPath := path.Join(dirPath, "model")
+
+	requireFileContains(t, path.Join(modelPath, "customer.go"), `
+type Customer struct {
+	ID          int32 `+"`sql:\"primary_key\"`"+`
+	Email       Email
+	HomeAddress *Address
+}
+`)
+	requireFileContains(t, path.Join(modelPath, "address.go"), `
+// Postal address
+type Address struct {
+	Street   *string
+	Zip      *int32
+	Location *GeoPoint
+}
+
+// Scan implements sql.Scanner interface for the composite type row literal
+func (c *Address) Scan(value interface{}) error {
+	return rowliteral.Scan(value, &c.Street, &c.Zip, &c.Location)
+}
+
+// Value implements driver.Valuer interface for the composite type row literal
+func (c Address) Value() (driver.Value, error) {
+	return rowliteral.Value(c.Street, c.Zip, c.Location)
+}
+`)
+	requireFileContains(t, path.Join(modelPath, "email.go"), `
+// Email address
+type Email string
+`)
+	require.NoFileExists(t, path.Join(modelPath, "uid.go"))
+}
+
+func TestProcessDomainModelsRenameAndSkip(t *testing.T) {
+	renamed := DefaultSchema(userDefinedTypesSchema).UseModel(DefaultModel().
+		UseDomain(func(domain metadata.Domain) DomainModel {
+			return DefaultDomainModel(domain).UseTypeName("EmailAddress").UseFileName("email_address")
+		}),
+	)
+
+	dirPath := t.TempDir()
+	require.NoError(t, processModel(dirPath, userDefinedTypesSchema, renamed))
+
+	requireFileContains(t, path.Join(dirPath, "model", "customer.go"), `
+	Email       EmailAddress
+`)
+	requireFileContains(t, path.Join(dirPath, "model", "email_address.go"), `
+type EmailAddress string
+`)
+
+	skipped := DefaultSchema(userDefinedTypesSchema).UseModel(DefaultModel().
+		UseDomain(func(domain metadata.Domain) DomainModel {
+			return DomainModel{Skip: true}
+		}),
+	)
+
+	dirPath = t.TempDir()
+	require.NoError(t, processModel(dirPath, userDefinedTypesSchema, skipped))
+
+	requireFileContains(t, path.Join(dirPath, "model", "customer.go"), `
+	Email       string
+`)
+	require.NoFileExists(t, path.Join(dirPath, "model", "email.go"))
+}
+
+func TestProcessCompositeSQLBuilder(t *testing.T) {
+	dirPath := t.TempDir()
+
+	err := processCompositeSQLBuilder(dirPath, postgres.Dialect, userDefinedTypesSchema.CompositesMetaData, DefaultSQLBuilder())
+	require.NoError(t, err)
+
+	requireFileContains(t, path.Join(dirPath, "composite", "address.go"), `
+// Postal address
+type Address struct {
+	postgres.Expression
+}
+
+// Street returns composite type attribute street
+func (c Address) Street() postgres.StringExpression {
+	return postgres.FIELD[postgres.StringExpression](c.Expression, "street")
+}
+
+// Zip returns composite type attribute zip
+func (c Address) Zip() postgres.IntegerExpression {
+	return postgres.FIELD[postgres.IntegerExpression](c.Expression, "zip")
+}
+
+// Location returns composite type attribute location
+func (c Address) Location() GeoPoint {
+	return GeoPoint{Expression: postgres.FIELD[postgres.Expression](c.Expression, "location")}
+}
+`)
+}
+
+func TestProcessEnumModels(t *testing.T) {
+	dirPath := t.TempDir()
+
+	enums := []metadata.Enum{
+		{Name: "mood", Values: []string{"sad", "happy"}},
+	}
+
+	err := processEnumModels(dirPath, enums, DefaultModel())
+	require.NoError(t, err)
+
+	filePath := path.Join(dirPath, "mood.go")
+
+	requireFileContains(t, filePath, `
+func ParseMood(label string) (Mood, error) {
+	switch label {
+	case "sad":
+		return Mood_Sad, nil
+	case "happy":
+		return Mood_Happy, nil
+	default:
+		return "", errors.New("jet: invalid value '" + label + "' for Mood enum")
+	}
+}
+`)
+	requireFileContains(t, filePath, `
+func (e Mood) IsValid() bool {`)
+	requireFileContains(t, filePath, `
+func (e Mood) Value() (driver.Value, error) {`)
+	requireFileContains(t, filePath, `
+func (e Mood) MarshalText() ([]byte, error) {`)
+	requireFileContains(t, filePath, `
+func (e *Mood) UnmarshalText(text []byte) error {`)
+	requireFileContains(t, filePath, `
+func (Mood) JSONSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type": "string",
+		"enum": []string{
+			"sad",
+			"happy",
+		},
+	}
+}
+`)
+}
+
+func TestProcessEnumModelsQuotedLabels(t *testing.T) {
+	dirPath := t.TempDir()
+
+	enums := []metadata.Enum{
+		{Name: "quote", Values: []string{`say "hi"`, `back\slash`}},
+	}
+
+	err := processEnumModels(dirPath, enums, DefaultModel())
+	require.NoError(t, err)
+
+	requireFileContains(t, path.Join(dirPath, "quote.go"), `
+const (
+	Quote_SayQuotationHiQuotation Quote = "say \"hi\""
+	Quote_BackBackslashSlash      Quote = "back\\slash"
+)
+`)
+	requireFileContains(t, path.Join(dirPath, "quote.go"), `
+func (e Quote) MarshalText() ([]byte, error) {
+	if e != "" && !e.IsValid() {`)
+}
+
+func TestDeduplicateEnums(t *testing.T) {
+	schema := metadata.Schema{
+		Name: "db",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "film",
+				Columns: []metadata.Column{
+					{Name: "rating", DataType: metadata.DataType{Name: "film_rating", Kind: metadata.EnumType}},
+					{Name: "audience", DataType: metadata.DataType{Name: "film_audience", Kind: metadata.EnumType}},
+				},
+			},
+		},
+		ViewsMetaData: []metadata.Table{
+			{
+				Name: "film_list",
+				Columns: []metadata.Column{
+					{Name: "rating", DataType: metadata.DataType{Name: "film_list_rating", Kind: metadata.EnumType}},
+				},
+			},
+		},
+		EnumsMetaData: []metadata.Enum{
+			{Name: "film_rating", Values: []string{"G", "PG"}},
+			{Name: "film_audience", Values: []string{"G", "PG"}},
+			{Name: "film_list_rating", Values: []string{"G", "PG"}},
+		},
+	}
+
+	modelTemplate := DefaultModel().UseEnum(func(enum metadata.Enum) EnumModel {
+		if enum.Name == "film_rating" {
+			return EnumModel{Skip: true}
+		}
+		return DefaultEnumModel(enum)
+	})
+
+	deduplicated := deduplicateEnums(schema, modelTemplate)
+
+	require.Equal(t, []metadata.Enum{
+		{Name: "film_rating", Values: []string{"G", "PG"}},
+		{Name: "film_audience", Values: []string{"G", "PG"}},
+	}, deduplicated.EnumsMetaData)
+	require.Equal(t, "film_rating", deduplicated.TablesMetaData[0].Columns[0].DataType.Name)
+	require.Equal(t, "film_audience", deduplicated.TablesMetaData[0].Columns[1].DataType.Name)
+	require.Equal(t, "film_audience", deduplicated.ViewsMetaData[0].Columns[0].DataType.Name)
+	// schema metadata columns are not modified
+	require.Equal(t, "film_list_rating", schema.ViewsMetaData[0].Columns[0].DataType.Name)
+}
+
+func TestProcessSetModelsAndSQLBuilder(t *testing.T) {
+	dirPath := t.TempDir()
+
+	schema := metadata.Schema{
+		Name: "db",
+		TablesMetaData: []metadata.Table{
+			{
+				Name: "user",
+				Columns: []metadata.Column{
+					{Name: "roles", IsNullable: true, DataType: metadata.DataType{Name: "user_roles", Kind: metadata.SetType}},
+				},
+			},
+		},
+		SetsMetaData: []metadata.Set{
+			{Name: "user_roles", Values: []string{"admin", "editor"}},
+		},
+	}
+
+	err := ProcessSchema(dirPath, schema, Default(mysql.Dialect))
+	require.NoError(t, err)
+
+	requireFileContains(t, path.Join(dirPath, "db", "model", "user.go"), `
+type User struct {
+	Roles *UserRoles
+}
+`)
+	requireFileContains(t, path.Join(dirPath, "db", "model", "user_roles.go"), `
+type UserRoles uint64
+
+const (
+	UserRoles_Admin  UserRoles = 1 << 0
+	UserRoles_Editor UserRoles = 1 << 1
+)
+`)
+	requireFileContains(t, path.Join(dirPath, "db", "model", "user_roles.go"), `
+func (s UserRoles) Labels() []string {
+	var labels []string
+	if s.Has(UserRoles_Admin) {
+		labels = append(labels, "admin")
+	}
+	if s.Has(UserRoles_Editor) {
+		labels = append(labels, "editor")
+	}
+
+	return labels
+}
+`)
+	requireFileContains(t, path.Join(dirPath, "db", "set", "user_roles.go"), `
+var UserRoles = &struct {
+	Admin  mysql.StringExpression
+	Editor mysql.StringExpression
+}{
+	Admin:  mysql.NewEnumValue("admin"),
+	Editor: mysql.NewEnumValue("editor"),
+}
+`)
+}
+
+func requireFileContains(t *testing.T, filePath, text string) {
+	content, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	require.Contains(t, string(content), text)
+}
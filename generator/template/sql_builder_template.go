@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/go-jet/jet/v2/internal/utils/dbidentifier"
+	"go/token"
 	"path"
+	"strconv"
 	"strings"
 	"unicode"
 )
@@ -16,15 +18,27 @@ type SQLBuilder struct {
 	Table func(table metadata.Table) TableSQLBuilder
 	View  func(view metadata.Table) TableSQLBuilder
 	Enum  func(enum metadata.Enum) EnumSQLBuilder
+	// Set is template for set types members. If not set, set types are not generated.
+	Set func(set metadata.Set) SetSQLBuilder
+	// Routine is template for stored functions and procedures. If not set, routines are not generated.
+	Routine func(routine metadata.Routine) RoutineSQLBuilder
+	// Sequence is template for sequences. If not set, sequences are not generated.
+	Sequence func(sequence metadata.Sequence) SequenceSQLBuilder
+	// Composite is template for composite types field access. If not set, composite types are not generated.
+	Composite func(composite metadata.Composite) CompositeSQLBuilder
 }
 
 // DefaultSQLBuilder returns default SQLBuilder implementation
 func DefaultSQLBuilder() SQLBuilder {
 	return SQLBuilder{
-		Path:  "",
-		Table: DefaultTableSQLBuilder,
-		View:  DefaultViewSQLBuilder,
-		Enum:  DefaultEnumSQLBuilder,
+		Path:      "",
+		Table:     DefaultTableSQLBuilder,
+		View:      DefaultViewSQLBuilder,
+		Enum:      DefaultEnumSQLBuilder,
+		Set:       DefaultSetSQLBuilder,
+		Routine:   DefaultRoutineSQLBuilder,
+		Sequence:  DefaultSequenceSQLBuilder,
+		Composite: DefaultCompositeSQLBuilder,
 	}
 }
 
@@ -52,6 +66,30 @@ func (sb SQLBuilder) UseEnum(enumFunc func(enum metadata.Enum) EnumSQLBuilder) S
 	return sb
 }
 
+// UseSet returns new SQLBuilder with new SetSQLBuilder template function set
+func (sb SQLBuilder) UseSet(setFunc func(set metadata.Set) SetSQLBuilder) SQLBuilder {
+	sb.Set = setFunc
+	return sb
+}
+
+// UseRoutine returns new SQLBuilder with new RoutineSQLBuilder template function set
+func (sb SQLBuilder) UseRoutine(routineFunc func(routine metadata.Routine) RoutineSQLBuilder) SQLBuilder {
+	sb.Routine = routineFunc
+	return sb
+}
+
+// UseSequence returns new SQLBuilder with new SequenceSQLBuilder template function set
+func (sb SQLBuilder) UseSequence(sequenceFunc func(sequence metadata.Sequence) SequenceSQLBuilder) SQLBuilder {
+	sb.Sequence = sequenceFunc
+	return sb
+}
+
+// UseComposite returns new SQLBuilder with new CompositeSQLBuilder template function set
+func (sb SQLBuilder) UseComposite(compositeFunc func(composite metadata.Composite) CompositeSQLBuilder) SQLBuilder {
+	sb.Composite = compositeFunc
+	return sb
+}
+
 // TableSQLBuilder is template for generating table SQLBuilder files
 type TableSQLBuilder struct {
 	Skip         bool
@@ -61,6 +99,14 @@ type TableSQLBuilder struct {
 	TypeName     string
 	DefaultAlias string
 	Column       func(columnMetaData metadata.Column) TableSQLBuilderColumn
+	// SoftDeleteColumn is the name of the soft delete column. If set, table sql builder is generated as soft delete
+	// table, excluding the rows with soft delete column set, and soft deleting the rows on DELETE statement.
+	// Soft delete is disabled by default, and it has to be enabled explicitly with UseSoftDeleteColumn.
+	SoftDeleteColumn string
+	// VersionColumn is the name of the optimistic lock version column. If set, UPDATE statement MODEL of the table
+	// increments the version column and checks the model version.
+	// Optimistic locking is disabled by default, and it has to be enabled explicitly with UseVersionColumn.
+	VersionColumn string
 }
 
 // ViewSQLBuilder is template for generating view SQLBuilder files
@@ -80,6 +126,34 @@ func DefaultTableSQLBuilder(tableMetaData metadata.Table) TableSQLBuilder {
 	}
 }
 
+// SoftDeleteColumn returns columnName if the table has nullable column with that name, or empty string otherwise.
+// It can be used to enable soft delete for all the tables with the same soft delete column:
+//
+//	DefaultTableSQLBuilder(table).UseSoftDeleteColumn(SoftDeleteColumn(table, "deleted_at"))
+func SoftDeleteColumn(tableMetaData metadata.Table, columnName string) string {
+	for _, column := range tableMetaData.Columns {
+		if column.Name == columnName && column.IsNullable {
+			return column.Name
+		}
+	}
+
+	return ""
+}
+
+// VersionColumn returns columnName if the table has not null integer column with that name, or empty string otherwise.
+// It can be used to enable optimistic locking for all the tables with the same version column:
+//
+//	DefaultTableSQLBuilder(table).UseVersionColumn(VersionColumn(table, "version"))
+func VersionColumn(tableMetaData metadata.Table, columnName string) string {
+	for _, column := range tableMetaData.Columns {
+		if column.Name == columnName && !column.IsNullable && getSqlBuilderColumnType(column) == "Integer" {
+			return column.Name
+		}
+	}
+
+	return ""
+}
+
 // DefaultViewSQLBuilder returns default implementation for ViewSQLBuilder
 func DefaultViewSQLBuilder(viewMetaData metadata.Table) ViewSQLBuilder {
 	tableSQLBuilder := DefaultTableSQLBuilder(viewMetaData)
@@ -128,6 +202,19 @@ func (tb TableSQLBuilder) UseColumn(columnsFunc func(column metadata.Column) Tab
 	return tb
 }
 
+// UseSoftDeleteColumn returns new TableSQLBuilder with new soft delete column name set. Empty name disables
+// soft delete behaviour of the table.
+func (tb TableSQLBuilder) UseSoftDeleteColumn(columnName string) TableSQLBuilder {
+	tb.SoftDeleteColumn = columnName
+	return tb
+}
+
+// UseVersionColumn returns new TableSQLBuilder with new optimistic lock version column name set
+func (tb TableSQLBuilder) UseVersionColumn(columnName string) TableSQLBuilder {
+	tb.VersionColumn = columnName
+	return tb
+}
+
 // TableSQLBuilderColumn is template for table sql builder column
 type TableSQLBuilderColumn struct {
 	Name string
@@ -142,6 +229,18 @@ func DefaultTableSQLBuilderColumn(columnMetaData metadata.Column) TableSQLBuilde
 	}
 }
 
+// DecimalTableSQLBuilderColumn returns implementation of TableSQLBuilderColumn, where numeric and decimal columns
+// are generated as decimal columns instead of float columns.
+func DecimalTableSQLBuilderColumn(columnMetaData metadata.Column) TableSQLBuilderColumn {
+	column := DefaultTableSQLBuilderColumn(columnMetaData)
+
+	if isDecimalColumn(columnMetaData) {
+		column.Type = "Decimal"
+	}
+
+	return column
+}
+
 // getSqlBuilderColumnType returns type of jet sql builder column
 func getSqlBuilderColumnType(columnMetaData metadata.Column) string {
 	if columnMetaData.DataType.Kind != metadata.BaseType &&
@@ -239,6 +338,14 @@ func (e EnumSQLBuilder) UseInstanceName(name string) EnumSQLBuilder {
 	return e
 }
 
+// SetSQLBuilder is template for generating set SQLBuilder files, containing set members
+type SetSQLBuilder = EnumSQLBuilder
+
+// DefaultSetSQLBuilder returns default implementation of SetSQLBuilder
+func DefaultSetSQLBuilder(setMetaData metadata.Set) SetSQLBuilder {
+	return DefaultEnumSQLBuilder(setMetaData).UsePath("/set")
+}
+
 func defaultEnumValueName(enumName, enumValue string) string {
 	enumValueName := dbidentifier.ToGoIdentifier(enumValue)
 	if !unicode.IsLetter([]rune(enumValueName)[0]) {
@@ -247,3 +354,255 @@ func defaultEnumValueName(enumName, enumValue string) string {
 
 	return enumValueName
 }
+
+// SequenceSQLBuilder is template for generating sequence SQLBuilder files
+type SequenceSQLBuilder struct {
+	Skip         bool
+	Path         string
+	FileName     string
+	InstanceName string
+}
+
+// DefaultSequenceSQLBuilder returns default implementation of SequenceSQLBuilder
+func DefaultSequenceSQLBuilder(sequenceMetaData metadata.Sequence) SequenceSQLBuilder {
+	return SequenceSQLBuilder{
+		Path:         "/sequence",
+		FileName:     dbidentifier.ToGoFileName(sequenceMetaData.Name),
+		InstanceName: dbidentifier.ToGoIdentifier(sequenceMetaData.Name),
+	}
+}
+
+// PackageName returns sequence sql builder package name
+func (s SequenceSQLBuilder) PackageName() string {
+	return path.Base(s.Path)
+}
+
+// UsePath returns new SequenceSQLBuilder with new path set
+func (s SequenceSQLBuilder) UsePath(path string) SequenceSQLBuilder {
+	s.Path = path
+	return s
+}
+
+// UseFileName returns new SequenceSQLBuilder with new file name set
+func (s SequenceSQLBuilder) UseFileName(name string) SequenceSQLBuilder {
+	s.FileName = name
+	return s
+}
+
+// UseInstanceName returns new SequenceSQLBuilder with new instance name set
+func (s SequenceSQLBuilder) UseInstanceName(name string) SequenceSQLBuilder {
+	s.InstanceName = name
+	return s
+}
+
+// CompositeSQLBuilder is template for generating composite type SQLBuilder files. Composite type is generated as
+// a wrapper around composite expression, with a method for each composite type attribute, for instance:
+//
+//	composite.Address{Expression: table.Customer.Address}.Street()
+type CompositeSQLBuilder struct {
+	Skip     bool
+	Path     string
+	FileName string
+	TypeName string
+	Field    func(attribute metadata.Column) CompositeSQLBuilderField
+}
+
+// DefaultCompositeSQLBuilder returns default implementation of CompositeSQLBuilder
+func DefaultCompositeSQLBuilder(compositeMetaData metadata.Composite) CompositeSQLBuilder {
+	return CompositeSQLBuilder{
+		Path:     "/composite",
+		FileName: dbidentifier.ToGoFileName(compositeMetaData.Name),
+		TypeName: dbidentifier.ToGoIdentifier(compositeMetaData.Name),
+		Field:    DefaultCompositeSQLBuilderField,
+	}
+}
+
+// PackageName returns composite sql builder package name
+func (c CompositeSQLBuilder) PackageName() string {
+	return path.Base(c.Path)
+}
+
+// UsePath returns new CompositeSQLBuilder with new path set
+func (c CompositeSQLBuilder) UsePath(path string) CompositeSQLBuilder {
+	c.Path = path
+	return c
+}
+
+// UseFileName returns new CompositeSQLBuilder with new file name set
+func (c CompositeSQLBuilder) UseFileName(name string) CompositeSQLBuilder {
+	c.FileName = name
+	return c
+}
+
+// UseTypeName returns new CompositeSQLBuilder with new type name set
+func (c CompositeSQLBuilder) UseTypeName(name string) CompositeSQLBuilder {
+	c.TypeName = name
+	return c
+}
+
+// UseField returns new CompositeSQLBuilder with new CompositeSQLBuilderField template function set
+func (c CompositeSQLBuilder) UseField(fieldFunc func(attribute metadata.Column) CompositeSQLBuilderField) CompositeSQLBuilder {
+	c.Field = fieldFunc
+	return c
+}
+
+// CompositeSQLBuilderField is template for composite type attribute accessor method. Type is dialect expression type
+// name, or the composite sql builder type name for the nested composite types.
+type CompositeSQLBuilderField struct {
+	Name        string
+	Type        string
+	IsComposite bool
+}
+
+// DefaultCompositeSQLBuilderField returns default implementation of CompositeSQLBuilderField
+func DefaultCompositeSQLBuilderField(attribute metadata.Column) CompositeSQLBuilderField {
+	if attribute.DataType.Kind == metadata.CompositeType {
+		return CompositeSQLBuilderField{
+			Name:        dbidentifier.ToGoIdentifier(attribute.Name),
+			Type:        dbidentifier.ToGoIdentifier(attribute.DataType.Name),
+			IsComposite: true,
+		}
+	}
+
+	return CompositeSQLBuilderField{
+		Name: dbidentifier.ToGoIdentifier(attribute.Name),
+		Type: getSqlBuilderExpressionType(attribute.Name, attribute.DataType),
+	}
+}
+
+// RoutineSQLBuilder is template for generating stored function and procedure SQLBuilder files. Scalar functions are
+// generated as typed expression constructors, functions returning rows as table function types, and procedures as
+// CALL statement constructors.
+type RoutineSQLBuilder struct {
+	Skip     bool
+	Path     string
+	FileName string
+	// FuncName is the name of generated Go function
+	FuncName string
+	// TypeName is the name of generated table function type
+	TypeName string
+	// ReturnType is the expression type returned by the scalar function constructor
+	ReturnType string
+	Argument   func(argument metadata.RoutineArgument, index int) RoutineSQLBuilderArgument
+	Column     func(columnMetaData metadata.Column) TableSQLBuilderColumn
+}
+
+// DefaultRoutineSQLBuilder returns default implementation of RoutineSQLBuilder
+func DefaultRoutineSQLBuilder(routine metadata.Routine) RoutineSQLBuilder {
+	funcName := dbidentifier.ToGoIdentifier(routine.Name)
+	returnType := ""
+
+	if !routine.IsProcedure() && !routine.IsTableFunction() {
+		returnType = getSqlBuilderExpressionType(routine.Name, routine.ReturnType)
+	}
+
+	return RoutineSQLBuilder{
+		Path:       "/routine",
+		FileName:   dbidentifier.ToGoFileName(routine.Name),
+		FuncName:   funcName,
+		TypeName:   funcName + "Table",
+		ReturnType: returnType,
+		Argument:   DefaultRoutineSQLBuilderArgument,
+		Column:     DefaultTableSQLBuilderColumn,
+	}
+}
+
+// PackageName returns routine sql builder package name
+func (r RoutineSQLBuilder) PackageName() string {
+	return path.Base(r.Path)
+}
+
+// UsePath returns new RoutineSQLBuilder with new path set
+func (r RoutineSQLBuilder) UsePath(path string) RoutineSQLBuilder {
+	r.Path = path
+	return r
+}
+
+// UseFileName returns new RoutineSQLBuilder with new file name set
+func (r RoutineSQLBuilder) UseFileName(name string) RoutineSQLBuilder {
+	r.FileName = name
+	return r
+}
+
+// UseFuncName returns new RoutineSQLBuilder with new Go function name set
+func (r RoutineSQLBuilder) UseFuncName(name string) RoutineSQLBuilder {
+	r.FuncName = name
+	return r
+}
+
+// UseTypeName returns new RoutineSQLBuilder with new table function type name set
+func (r RoutineSQLBuilder) UseTypeName(name string) RoutineSQLBuilder {
+	r.TypeName = name
+	return r
+}
+
+// UseArgument returns new RoutineSQLBuilder with new argument template function set
+func (r RoutineSQLBuilder) UseArgument(argumentFunc func(argument metadata.RoutineArgument, index int) RoutineSQLBuilderArgument) RoutineSQLBuilder {
+	r.Argument = argumentFunc
+	return r
+}
+
+// UseColumn returns new RoutineSQLBuilder with new table function column template function set
+func (r RoutineSQLBuilder) UseColumn(columnFunc func(columnMetaData metadata.Column) TableSQLBuilderColumn) RoutineSQLBuilder {
+	r.Column = columnFunc
+	return r
+}
+
+// RoutineSQLBuilderArgument is template for routine sql builder argument
+type RoutineSQLBuilderArgument struct {
+	Name string
+	Type string
+}
+
+// DefaultRoutineSQLBuilderArgument returns default implementation of RoutineSQLBuilderArgument
+func DefaultRoutineSQLBuilderArgument(argument metadata.RoutineArgument, index int) RoutineSQLBuilderArgument {
+	name := "arg" + strconv.Itoa(index+1)
+
+	if argument.Name != "" {
+		goIdentifier := dbidentifier.ToGoIdentifier(argument.Name)
+		name = strings.ToLower(goIdentifier[:1]) + goIdentifier[1:]
+	}
+
+	if token.IsKeyword(name) {
+		name += "Arg"
+	}
+
+	return RoutineSQLBuilderArgument{
+		Name: name,
+		Type: getSqlBuilderExpressionType(argument.Name, argument.DataType),
+	}
+}
+
+// getSqlBuilderExpressionType returns type of jet sql builder expression
+func getSqlBuilderExpressionType(name string, dataType metadata.DataType) string {
+	if dataType.Name == "void" {
+		return "Expression"
+	}
+
+	columnType := getSqlBuilderColumnType(metadata.Column{Name: name, DataType: dataType})
+
+	if strings.HasSuffix(columnType, "Range") {
+		return columnType
+	}
+
+	return columnType + "Expression"
+}
+
+var expressionWrappers = map[string]string{
+	"BoolExpression":       "BoolExp",
+	"IntegerExpression":    "IntExp",
+	"FloatExpression":      "FloatExp",
+	"StringExpression":     "StringExp",
+	"DateExpression":       "DateExp",
+	"TimeExpression":       "TimeExp",
+	"TimezExpression":      "TimezExp",
+	"TimestampExpression":  "TimestampExp",
+	"TimestampzExpression": "TimestampzExp",
+	"IntervalExpression":   "IntervalExp",
+	"DateRange":            "DateRangeExp",
+	"TimestampRange":       "TsRangeExp",
+	"TimestampzRange":      "TstzRangeExp",
+	"Int4Range":            "Int4RangeExp",
+	"Int8Range":            "Int8RangeExp",
+	"NumericRange":         "NumRangeExp",
+}
@@ -1,6 +1,7 @@
 package template
 
 import (
+	"github.com/go-jet/jet/v2/generator/metadata"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -9,3 +10,53 @@ func TestToGoEnumValueIdentifier(t *testing.T) {
 	require.Equal(t, defaultEnumValueName("enum_name", "enum_value"), "EnumValue")
 	require.Equal(t, defaultEnumValueName("NumEnum", "100"), "NumEnum100")
 }
+
+func TestDefaultTableSQLBuilderSoftDeleteColumn(t *testing.T) {
+	table := metadata.Table{
+		Name: "users",
+		Columns: []metadata.Column{
+			{Name: "id", IsPrimaryKey: true},
+			{Name: "deleted_at", IsNullable: true},
+		},
+	}
+
+	require.Equal(t, "", DefaultTableSQLBuilder(table).SoftDeleteColumn)
+	require.Equal(t, "", DefaultViewSQLBuilder(table).SoftDeleteColumn)
+	require.Equal(t, "removed_at", DefaultTableSQLBuilder(table).UseSoftDeleteColumn("removed_at").SoftDeleteColumn)
+
+	require.Equal(t, "deleted_at", SoftDeleteColumn(table, "deleted_at"))
+	require.Equal(t, "", SoftDeleteColumn(table, "removed_at"))
+
+	table.Columns[1].IsNullable = false
+	require.Equal(t, "", SoftDeleteColumn(table, "deleted_at"))
+}
+
+func TestTableSQLBuilderVersionColumn(t *testing.T) {
+	table := metadata.Table{
+		Name: "users",
+		Columns: []metadata.Column{
+			{Name: "id", IsPrimaryKey: true},
+			{Name: "version", DataType: metadata.DataType{Name: "integer", Kind: metadata.BaseType}},
+			{Name: "name", DataType: metadata.DataType{Name: "text", Kind: metadata.BaseType}},
+		},
+	}
+
+	require.Equal(t, "", DefaultTableSQLBuilder(table).VersionColumn)
+	require.Equal(t, "version", DefaultTableSQLBuilder(table).UseVersionColumn(VersionColumn(table, "version")).VersionColumn)
+	require.Equal(t, "", VersionColumn(table, "name"))
+	require.Equal(t, "", VersionColumn(table, "revision"))
+
+	table.Columns[1].IsNullable = true
+	require.Equal(t, "", VersionColumn(table, "version"))
+}
+
+func TestDecimalTableSQLBuilderColumn(t *testing.T) {
+	numeric := metadata.Column{Name: "unit_price", DataType: metadata.DataType{Name: "numeric", Kind: metadata.BaseType}}
+	decimal := metadata.Column{Name: "tax", DataType: metadata.DataType{Name: "decimal", Kind: metadata.BaseType}}
+	double := metadata.Column{Name: "ratio", DataType: metadata.DataType{Name: "double precision", Kind: metadata.BaseType}}
+
+	require.Equal(t, TableSQLBuilderColumn{Name: "UnitPrice", Type: "Float"}, DefaultTableSQLBuilderColumn(numeric))
+	require.Equal(t, TableSQLBuilderColumn{Name: "UnitPrice", Type: "Decimal"}, DecimalTableSQLBuilderColumn(numeric))
+	require.Equal(t, TableSQLBuilderColumn{Name: "Tax", Type: "Decimal"}, DecimalTableSQLBuilderColumn(decimal))
+	require.Equal(t, TableSQLBuilderColumn{Name: "Ratio", Type: "Float"}, DecimalTableSQLBuilderColumn(double))
+}
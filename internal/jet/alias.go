@@ -27,6 +27,6 @@ func (a *alias) fromImpl(subQuery SelectTable) Projection {
 func (a *alias) serializeForProjection(statement StatementType, out *SQLBuilder) {
 	a.expression.serialize(statement, out)
 
-	out.WriteString("AS")
+	out.WriteKeyword("AS")
 	out.WriteAlias(a.alias)
 }
@@ -46,8 +46,9 @@ func (b *castExpression) serialize(statement StatementType, out *SQLBuilder, opt
 		return
 	}
 
-	out.WriteString("CAST(")
+	out.WriteFunctionCall("CAST")
 	expression.serialize(statement, out, FallTrough(options)...)
-	out.WriteString("AS")
-	out.WriteString(castType + ")")
+	out.WriteKeyword("AS")
+	out.WriteKeyword(castType)
+	out.WriteString(")")
 }
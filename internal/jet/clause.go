@@ -1,6 +1,10 @@
 package jet
 
-import "github.com/go-jet/jet/v2/internal/utils/is"
+import (
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/utils/is"
+)
 
 // Clause interface
 type Clause interface {
@@ -43,6 +47,9 @@ type ClauseSelect struct {
 
 	// MySQL only
 	OptimizerHints optimizerHints
+
+	// SQL Server only
+	Top IntegerExpression
 }
 
 // Projections returns list of projections for select clause
@@ -53,19 +60,27 @@ func (s *ClauseSelect) Projections() ProjectionList {
 // Serialize serializes clause into SQLBuilder
 func (s *ClauseSelect) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
 	out.NewLine()
-	out.WriteString("SELECT")
+	out.WriteKeyword("SELECT")
 	s.OptimizerHints.Serialize(statementType, out, options...)
 
 	if s.Distinct {
-		out.WriteString("DISTINCT")
+		out.WriteKeyword("DISTINCT")
 	}
 
 	if len(s.DistinctOnColumns) > 0 {
-		out.WriteString("ON (")
+		out.WriteKeyword("ON")
+		out.WriteString("(")
 		SerializeColumnExpressions(s.DistinctOnColumns, statementType, out)
 		out.WriteByte(')')
 	}
 
+	if !is.Nil(s.Top) {
+		out.WriteKeyword("TOP")
+		out.WriteString("(")
+		s.Top.serialize(statementType, out, FallTrough(options)...)
+		out.WriteByte(')')
+	}
+
 	if len(s.ProjectionList) == 0 {
 		panic("jet: SELECT clause has to have at least one projection")
 	}
@@ -77,6 +92,10 @@ func (s *ClauseSelect) Serialize(statementType StatementType, out *SQLBuilder, o
 type ClauseFrom struct {
 	Name   string
 	Tables []Serializer
+
+	// SoftDeleteName replaces clause name if DELETE statement is serialized as soft delete UPDATE statement.
+	// If not set, clause can not be used with soft delete statements.
+	SoftDeleteName string
 }
 
 // Serialize serializes clause into SQLBuilder
@@ -85,10 +104,15 @@ func (f *ClauseFrom) Serialize(statementType StatementType, out *SQLBuilder, opt
 		return
 	}
 	out.NewLine()
-	if f.Name != "" {
-		out.WriteString(f.Name)
+	if out.isSoftDelete() {
+		if f.SoftDeleteName == "" {
+			panic(fmt.Sprintf("jet: %s clause is not supported for soft delete tables, use Unscoped statement", f.Name))
+		}
+		out.WriteKeyword(f.SoftDeleteName)
+	} else if f.Name != "" {
+		out.WriteKeyword(f.Name)
 	} else {
-		out.WriteString("FROM")
+		out.WriteKeyword("FROM")
 	}
 
 	out.IncreaseIdent()
@@ -106,23 +130,56 @@ func (f *ClauseFrom) Serialize(statementType StatementType, out *SQLBuilder, opt
 type ClauseWhere struct {
 	Condition BoolExpression
 	Mandatory bool
+
+	// Keyset is keyset pagination condition added to the WHERE condition
+	Keyset BoolExpression
+	// VersionCheck is optimistic lock version condition added to the WHERE condition
+	VersionCheck BoolExpression
+	// ConflictAction is set for the WHERE clause of ON CONFLICT DO UPDATE action, which is extended with the
+	// predicates of the filtered INSERT statement table
+	ConflictAction bool
 }
 
 // Serialize serializes clause into SQLBuilder
 func (c *ClauseWhere) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
-	if c.Condition == nil {
-		if c.Mandatory {
-			panic("jet: WHERE clause not set")
+	if c.Condition == nil && c.Mandatory {
+		panic("jet: WHERE clause not set")
+	}
+
+	condition := c.Condition
+
+	for _, additional := range []BoolExpression{c.Keyset, c.VersionCheck} {
+		if additional == nil {
+			continue
 		}
+
+		if condition == nil {
+			condition = additional
+		} else {
+			condition = condition.AND(additional)
+		}
+	}
+
+	if c.VersionCheck != nil {
+		out.versionChecked = true
+	}
+
+	if c.ConflictAction {
+		condition = out.filterConflictCondition(condition)
+	} else {
+		condition = out.filterWhereCondition(condition)
+	}
+
+	if condition == nil {
 		return
 	}
 	if !contains(options, SkipNewLine) {
 		out.NewLine()
 	}
-	out.WriteString("WHERE")
+	out.WriteKeyword("WHERE")
 
 	out.IncreaseIdent(6)
-	c.Condition.serialize(statementType, out, NoWrap.WithFallTrough(options)...)
+	condition.serialize(statementType, out, NoWrap.WithFallTrough(options)...)
 	out.DecreaseIdent(6)
 }
 
@@ -138,7 +195,7 @@ func (c *ClauseGroupBy) Serialize(statementType StatementType, out *SQLBuilder,
 	}
 
 	out.NewLine()
-	out.WriteString("GROUP BY")
+	out.WriteKeyword("GROUP BY")
 
 	out.IncreaseIdent()
 
@@ -169,7 +226,7 @@ func (c *ClauseHaving) Serialize(statementType StatementType, out *SQLBuilder, o
 	}
 
 	out.NewLine()
-	out.WriteString("HAVING")
+	out.WriteKeyword("HAVING")
 
 	out.IncreaseIdent()
 	c.Condition.serialize(statementType, out, NoWrap.WithFallTrough(options)...)
@@ -191,7 +248,7 @@ func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder,
 	if !o.SkipNewLine {
 		out.NewLine()
 	}
-	out.WriteString("ORDER BY")
+	out.WriteKeyword("ORDER BY")
 
 	out.IncreaseIdent()
 
@@ -209,13 +266,23 @@ func (o *ClauseOrderBy) Serialize(statementType StatementType, out *SQLBuilder,
 // ClauseLimit struct
 type ClauseLimit struct {
 	Count int64
+	// CountExpression, if set, is used as limit instead of Count
+	CountExpression IntegerExpression
 }
 
 // Serialize serializes clause into SQLBuilder
 func (l *ClauseLimit) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if !is.Nil(l.CountExpression) {
+		out.NewLine()
+		out.WriteKeyword("LIMIT")
+		l.CountExpression.serialize(statementType, out, options...)
+		return
+	}
+
 	if l.Count >= 0 {
 		out.NewLine()
-		out.WriteString("LIMIT")
+		out.WriteKeyword("LIMIT")
+		out.visit(Node{Kind: LiteralNode, Value: l.Count})
 		out.insertParametrizedArgument(l.Count)
 	}
 }
@@ -232,7 +299,7 @@ func (o *ClauseOffset) Serialize(statementType StatementType, out *SQLBuilder, o
 	}
 
 	out.NewLine()
-	out.WriteString("OFFSET")
+	out.WriteKeyword("OFFSET")
 	o.Count.serialize(statementType, out, options...)
 }
 
@@ -249,13 +316,13 @@ func (o *ClauseFetch) Serialize(statementType StatementType, out *SQLBuilder, op
 	}
 
 	out.NewLine()
-	out.WriteString("FETCH FIRST")
+	out.WriteKeyword("FETCH FIRST")
 	o.Count.serialize(statementType, out, options...)
 
 	if o.WithTies {
-		out.WriteString("ROWS WITH TIES")
+		out.WriteKeyword("ROWS WITH TIES")
 	} else {
-		out.WriteString("ROWS ONLY")
+		out.WriteKeyword("ROWS ONLY")
 	}
 }
 
@@ -271,7 +338,7 @@ func (f *ClauseFor) Serialize(statementType StatementType, out *SQLBuilder, opti
 	}
 
 	out.NewLine()
-	out.WriteString("FOR")
+	out.WriteKeyword("FOR")
 	f.Lock.serialize(statementType, out, FallTrough(options)...)
 }
 
@@ -307,10 +374,10 @@ func (s *ClauseSetStmtOperator) Serialize(statementType StatementType, out *SQLB
 				out.NewLine()
 			}
 
-			out.WriteString(s.Operator)
+			out.WriteKeyword(s.Operator)
 
 			if s.All {
-				out.WriteString("ALL")
+				out.WriteKeyword("ALL")
 			}
 			out.NewLine()
 		}
@@ -342,7 +409,7 @@ type ClauseUpdate struct {
 // Serialize serializes clause into SQLBuilder
 func (u *ClauseUpdate) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
 	out.NewLine()
-	out.WriteString("UPDATE")
+	out.WriteKeyword("UPDATE")
 	u.OptimizerHints.Serialize(statementType, out, options...)
 
 	if is.Nil(u.Table) {
@@ -364,7 +431,7 @@ func (s *SetClause) Serialize(statementType StatementType, out *SQLBuilder, opti
 		return
 	}
 	out.NewLine()
-	out.WriteString("SET")
+	out.WriteKeyword("SET")
 
 	if len(s.Columns) != len(s.Values) {
 		panic("jet: mismatch in numbers of columns and values for SET clause")
@@ -381,6 +448,7 @@ func (s *SetClause) Serialize(statementType StatementType, out *SQLBuilder, opti
 			panic("jet: nil column in columns list for SET clause")
 		}
 
+		out.visit(Node{Kind: ColumnNode, Table: column.TableName(), Column: column.Name()})
 		out.WriteIdentifier(column.Name())
 
 		out.WriteString(" = ")
@@ -415,16 +483,18 @@ func (i *ClauseInsert) Serialize(statementType StatementType, out *SQLBuilder, o
 	}
 
 	out.NewLine()
-	out.WriteString("INSERT")
+	out.WriteKeyword("INSERT")
 	i.OptimizerHints.Serialize(statementType, out, options...)
-	out.WriteString("INTO")
+	out.WriteKeyword("INTO")
 
-	i.Table.serialize(statementType, out)
+	out.filterInsertTable(statementType, i.Table, i.GetColumns())
 
-	if len(i.Columns) > 0 {
+	columns := append(i.Columns[:len(i.Columns):len(i.Columns)], out.filterInsertColumns(len(i.Columns) > 0)...)
+
+	if len(columns) > 0 {
 		out.WriteString("(")
 
-		SerializeColumnNames(i.Columns, out)
+		SerializeColumnNames(columns, out)
 
 		out.WriteString(")")
 	}
@@ -459,7 +529,7 @@ func (v *ClauseValues) Serialize(statementType StatementType, out *SQLBuilder, o
 	}
 
 	out.NewLine()
-	out.WriteString("VALUES")
+	out.WriteKeyword("VALUES")
 
 	for rowIndex, row := range v.Rows {
 		if rowIndex > 0 {
@@ -471,13 +541,13 @@ func (v *ClauseValues) Serialize(statementType StatementType, out *SQLBuilder, o
 
 		out.WriteString("(")
 
-		SerializeClauseList(statementType, row, out)
+		SerializeClauseList(statementType, out.filterInsertRow(row), out)
 
 		out.WriteByte(')')
 	}
 
 	if len(v.As) > 0 {
-		out.WriteString("AS")
+		out.WriteKeyword("AS")
 		out.WriteIdentifier(v.As)
 	}
 
@@ -496,6 +566,8 @@ func (v *ClauseQuery) Serialize(statementType StatementType, out *SQLBuilder, op
 		return
 	}
 
+	out.filterInsertQuery()
+
 	if v.SkipSelectWrap {
 		options = append(FallTrough(options), NoWrap)
 	}
@@ -514,9 +586,22 @@ type ClauseDelete struct {
 // Serialize serializes clause into SQLBuilder
 func (d *ClauseDelete) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
 	out.NewLine()
-	out.WriteString("DELETE")
+
+	if softDeleteColumn := out.softDeleteTable(d.Table); softDeleteColumn != nil {
+		out.WriteKeyword("UPDATE")
+		d.OptimizerHints.Serialize(statementType, out, options...)
+		d.Table.serialize(statementType, out, FallTrough(options)...)
+		out.NewLine()
+		out.WriteKeyword("SET")
+		out.WriteIdentifier(softDeleteColumn.Name())
+		out.WriteString("=")
+		out.WriteKeyword("CURRENT_TIMESTAMP")
+		return
+	}
+
+	out.WriteKeyword("DELETE")
 	d.OptimizerHints.Serialize(statementType, out, options...)
-	out.WriteString("FROM")
+	out.WriteKeyword("FROM")
 	d.Table.serialize(statementType, out, FallTrough(options)...)
 }
 
@@ -529,7 +614,7 @@ type ClauseStatementBegin struct {
 // Serialize serializes clause into SQLBuilder
 func (d *ClauseStatementBegin) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
 	out.NewLine()
-	out.WriteString(d.Name)
+	out.WriteKeyword(d.Name)
 
 	for i, table := range d.Tables {
 		if i > 0 {
@@ -555,7 +640,7 @@ func (d *ClauseOptional) Serialize(statementType StatementType, out *SQLBuilder,
 	if d.InNewLine {
 		out.NewLine()
 	}
-	out.WriteString(d.Name)
+	out.WriteKeyword(d.Name)
 }
 
 // ClauseIn struct
@@ -569,9 +654,9 @@ func (i *ClauseIn) Serialize(statementType StatementType, out *SQLBuilder, optio
 		return
 	}
 
-	out.WriteString("IN")
-	out.WriteString(string(i.LockMode))
-	out.WriteString("MODE")
+	out.WriteKeyword("IN")
+	out.WriteKeyword(string(i.LockMode))
+	out.WriteKeyword("MODE")
 }
 
 // WindowDefinition struct
@@ -592,14 +677,14 @@ func (i *ClauseWindow) Serialize(statementType StatementType, out *SQLBuilder, o
 	}
 
 	out.NewLine()
-	out.WriteString("WINDOW")
+	out.WriteKeyword("WINDOW")
 
 	for i, def := range i.Definitions {
 		if i > 0 {
 			out.WriteString(", ")
 		}
 		out.WriteString(def.Name)
-		out.WriteString("AS")
+		out.WriteKeyword("AS")
 		if def.Window == nil {
 			out.WriteString("()")
 			continue
@@ -623,7 +708,7 @@ func (s SetClauseNew) Serialize(statementType StatementType, out *SQLBuilder, op
 		return
 	}
 	out.NewLine()
-	out.WriteString("SET")
+	out.WriteKeyword("SET")
 	out.IncreaseIdent(4)
 
 	for i, assigment := range s {
@@ -660,7 +745,7 @@ func (r *ClauseReturning) Serialize(statementType StatementType, out *SQLBuilder
 	}
 
 	out.NewLine()
-	out.WriteString("RETURNING")
+	out.WriteKeyword("RETURNING")
 	out.IncreaseIdent()
 	out.WriteProjections(statementType, r.ProjectionList)
 	out.DecreaseIdent()
@@ -670,3 +755,60 @@ func (r *ClauseReturning) Serialize(statementType StatementType, out *SQLBuilder
 func (r ClauseReturning) Projections() ProjectionList {
 	return r.ProjectionList
 }
+
+// ClauseOutput is SQL Server OUTPUT clause. Columns of the clause projections are qualified with the Qualifier,
+// INSERTED or DELETED pseudo table.
+type ClauseOutput struct {
+	Qualifier      string
+	ProjectionList []Projection
+}
+
+// Serialize for ClauseOutput
+func (o *ClauseOutput) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if len(o.ProjectionList) == 0 {
+		return
+	}
+
+	out.NewLine()
+	out.WriteKeyword("OUTPUT")
+	out.IncreaseIdent()
+	out.allColumnsQualifier = o.Qualifier
+	out.WriteProjections(statementType, o.ProjectionList)
+	out.allColumnsQualifier = ""
+	out.DecreaseIdent()
+}
+
+// Projections for ClauseOutput
+func (o ClauseOutput) Projections() ProjectionList {
+	return o.ProjectionList
+}
+
+// ClauseMerge is MERGE statement target table, source table and ON condition
+type ClauseMerge struct {
+	Table SerializerTable
+	Using Serializer
+	On    BoolExpression
+}
+
+// Serialize for ClauseMerge
+func (m *ClauseMerge) Serialize(statementType StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if is.Nil(m.Table) {
+		panic("jet: MERGE statement table is nil")
+	}
+
+	if is.Nil(m.Using) {
+		panic("jet: MERGE statement USING source is not set")
+	}
+
+	if m.On == nil {
+		panic("jet: MERGE statement ON condition is not set")
+	}
+
+	out.NewLine()
+	out.WriteKeyword("MERGE INTO")
+	out.filterMergeTable(statementType, m.Table, m.Using, FallTrough(options)...)
+
+	out.NewLine()
+	out.WriteKeyword("ON")
+	out.filterWhereCondition(m.On).serialize(statementType, out, NoWrap.WithFallTrough(options)...)
+}
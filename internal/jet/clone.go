@@ -0,0 +1,43 @@
+package jet
+
+import "reflect"
+
+// CloneSlices replaces all the slices reachable through exported fields of the struct structPtr points to,
+// including slices of nested structs and slices of slices, with their copies. Statement clauses are copied this way,
+// so that builder methods of the cloned statement can not modify clauses of the original statement.
+// Pointers, interfaces and maps are not followed, because expressions and tables are not modified once constructed.
+func CloneSlices(structPtr interface{}) {
+	value := reflect.ValueOf(structPtr)
+
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		panic("jet: internal error. CloneSlices expects pointer to struct.")
+	}
+
+	cloneSlices(value.Elem())
+}
+
+func cloneSlices(value reflect.Value) {
+	switch value.Kind() {
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Field(i)
+
+			if field.CanSet() {
+				cloneSlices(field)
+			}
+		}
+	case reflect.Slice:
+		if value.IsNil() {
+			return
+		}
+
+		newSlice := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		reflect.Copy(newSlice, value)
+
+		for i := 0; i < newSlice.Len(); i++ {
+			cloneSlices(newSlice.Index(i))
+		}
+
+		value.Set(newSlice)
+	}
+}
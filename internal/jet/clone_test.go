@@ -0,0 +1,44 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneSlices(t *testing.T) {
+	type clauses struct {
+		Select ClauseSelect
+		Values ClauseValues
+		SetNew SetClauseNew
+		Empty  []Serializer
+		hidden []Serializer
+	}
+
+	hidden := []Serializer{Int(3)}
+
+	original := clauses{
+		Select: ClauseSelect{ProjectionList: []Projection{Int(1)}},
+		Values: ClauseValues{Rows: [][]Serializer{{Int(1), Int(2)}}},
+		SetNew: SetClauseNew{},
+		hidden: hidden,
+	}
+
+	clone := original
+	CloneSlices(&clone)
+
+	clone.Select.ProjectionList[0] = Int(11)
+	clone.Values.Rows[0][1] = Int(22)
+	clone.Values.Rows = append(clone.Values.Rows, []Serializer{Int(33)})
+
+	require.Equal(t, Int(1), original.Select.ProjectionList[0])
+	require.Equal(t, Int(2), original.Values.Rows[0][1])
+	require.Len(t, original.Values.Rows, 1)
+	require.NotNil(t, clone.SetNew)
+	require.Nil(t, clone.Empty)
+	require.Equal(t, hidden, clone.hidden) // unexported fields are not cloned
+
+	require.PanicsWithValue(t, "jet: internal error. CloneSlices expects pointer to struct.", func() {
+		CloneSlices(original)
+	})
+}
@@ -96,19 +96,32 @@ func (c *ColumnExpressionImpl) serializeForOrderBy(statement StatementType, out
 func (c ColumnExpressionImpl) serializeForProjection(statement StatementType, out *SQLBuilder) {
 	c.serialize(statement, out)
 
-	out.WriteString("AS")
+	out.WriteKeyword("AS")
 	out.WriteAlias(c.defaultAlias())
 }
 
 func (c ColumnExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 
 	if c.subQuery != nil {
+		out.visit(Node{Kind: ColumnNode, Table: c.subQuery.Alias(), Column: c.defaultAlias()})
+
 		out.WriteIdentifier(c.subQuery.Alias())
 		out.WriteByte('.')
 		out.WriteIdentifier(c.defaultAlias())
 	} else {
-		if c.tableName != "" && !contains(options, ShortName) {
-			out.WriteIdentifier(c.tableName)
+		out.visit(Node{Kind: ColumnNode, Table: c.tableName, Column: c.name})
+
+		tableName := c.tableName
+
+		if qualifier, ok := out.columnQualifiers[tableName]; ok {
+			tableName = qualifier
+		}
+
+		if out.allColumnsQualifier != "" && !contains(options, ShortName) {
+			out.WriteString(out.allColumnsQualifier)
+			out.WriteByte('.')
+		} else if tableName != "" && !contains(options, ShortName) {
+			out.WriteIdentifier(tableName)
 			out.WriteByte('.')
 		}
 
@@ -80,6 +80,46 @@ func FloatColumn(name string) ColumnFloat {
 
 //------------------------------------------------------//
 
+// ColumnDecimal is interface for SQL numeric or decimal column.
+type ColumnDecimal interface {
+	DecimalExpression
+	Column
+
+	From(subQuery SelectTable) ColumnDecimal
+	SET(decimalExp DecimalExpression) ColumnAssigment
+}
+
+type decimalColumnImpl struct {
+	decimalInterfaceImpl
+	ColumnExpressionImpl
+}
+
+func (i *decimalColumnImpl) From(subQuery SelectTable) ColumnDecimal {
+	newDecimalColumn := DecimalColumn(i.name)
+	newDecimalColumn.setTableName(i.tableName)
+	newDecimalColumn.setSubQuery(subQuery)
+
+	return newDecimalColumn
+}
+
+func (i *decimalColumnImpl) SET(decimalExp DecimalExpression) ColumnAssigment {
+	return columnAssigmentImpl{
+		column:     i,
+		expression: decimalExp,
+	}
+}
+
+// DecimalColumn creates named decimal column.
+func DecimalColumn(name string) ColumnDecimal {
+	decimalColumn := &decimalColumnImpl{}
+	decimalColumn.decimalInterfaceImpl.parent = decimalColumn
+	decimalColumn.ColumnExpressionImpl = NewColumnImpl(name, "", decimalColumn)
+
+	return decimalColumn
+}
+
+//------------------------------------------------------//
+
 // ColumnInteger is interface for SQL smallint, integer, bigint columns.
 type ColumnInteger interface {
 	IntegerExpression
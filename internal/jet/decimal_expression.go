@@ -0,0 +1,117 @@
+package jet
+
+// DecimalExpression is interface for SQL numeric and decimal expressions. Decimal expression can be compared with
+// any numeric expression, and arithmetic operations on decimal expressions return DecimalExpression, so decimal
+// values never lose precision on the go side. Use FloatExp to pass decimal expression where float expression is
+// expected. Jet never adds a cast to float type.
+type DecimalExpression interface {
+	Expression
+	numericExpression
+
+	EQ(rhs NumericExpression) BoolExpression
+	NOT_EQ(rhs NumericExpression) BoolExpression
+	IS_DISTINCT_FROM(rhs NumericExpression) BoolExpression
+	IS_NOT_DISTINCT_FROM(rhs NumericExpression) BoolExpression
+
+	LT(rhs NumericExpression) BoolExpression
+	LT_EQ(rhs NumericExpression) BoolExpression
+	GT(rhs NumericExpression) BoolExpression
+	GT_EQ(rhs NumericExpression) BoolExpression
+	BETWEEN(min, max NumericExpression) BoolExpression
+	NOT_BETWEEN(min, max NumericExpression) BoolExpression
+
+	ADD(rhs NumericExpression) DecimalExpression
+	SUB(rhs NumericExpression) DecimalExpression
+	MUL(rhs NumericExpression) DecimalExpression
+	DIV(rhs NumericExpression) DecimalExpression
+	MOD(rhs NumericExpression) DecimalExpression
+
+	isDecimalExpression()
+}
+
+type decimalInterfaceImpl struct {
+	numericExpressionImpl
+	parent DecimalExpression
+}
+
+func (d *decimalInterfaceImpl) isDecimalExpression() {}
+
+func (d *decimalInterfaceImpl) EQ(rhs NumericExpression) BoolExpression {
+	return Eq(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) NOT_EQ(rhs NumericExpression) BoolExpression {
+	return NotEq(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) IS_DISTINCT_FROM(rhs NumericExpression) BoolExpression {
+	return IsDistinctFrom(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) IS_NOT_DISTINCT_FROM(rhs NumericExpression) BoolExpression {
+	return IsNotDistinctFrom(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) GT(rhs NumericExpression) BoolExpression {
+	return Gt(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) GT_EQ(rhs NumericExpression) BoolExpression {
+	return GtEq(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) LT(rhs NumericExpression) BoolExpression {
+	return Lt(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) LT_EQ(rhs NumericExpression) BoolExpression {
+	return LtEq(d.parent, rhs)
+}
+
+func (d *decimalInterfaceImpl) BETWEEN(min, max NumericExpression) BoolExpression {
+	return NewBetweenOperatorExpression(d.parent, min, max, false)
+}
+
+func (d *decimalInterfaceImpl) NOT_BETWEEN(min, max NumericExpression) BoolExpression {
+	return NewBetweenOperatorExpression(d.parent, min, max, true)
+}
+
+func (d *decimalInterfaceImpl) ADD(rhs NumericExpression) DecimalExpression {
+	return DecimalExp(Add(d.parent, rhs))
+}
+
+func (d *decimalInterfaceImpl) SUB(rhs NumericExpression) DecimalExpression {
+	return DecimalExp(Sub(d.parent, rhs))
+}
+
+func (d *decimalInterfaceImpl) MUL(rhs NumericExpression) DecimalExpression {
+	return DecimalExp(Mul(d.parent, rhs))
+}
+
+func (d *decimalInterfaceImpl) DIV(rhs NumericExpression) DecimalExpression {
+	return DecimalExp(Div(d.parent, rhs))
+}
+
+func (d *decimalInterfaceImpl) MOD(rhs NumericExpression) DecimalExpression {
+	return DecimalExp(Mod(d.parent, rhs))
+}
+
+//---------------------------------------------------//
+
+type decimalExpressionWrapper struct {
+	decimalInterfaceImpl
+	Expression
+}
+
+func newDecimalExpressionWrap(expression Expression) DecimalExpression {
+	decimalExpressionWrap := decimalExpressionWrapper{Expression: expression}
+	decimalExpressionWrap.decimalInterfaceImpl.parent = &decimalExpressionWrap
+	return &decimalExpressionWrap
+}
+
+// DecimalExp is decimal expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as decimal expression.
+// Does not add sql cast to generated sql builder output.
+func DecimalExp(expression Expression) DecimalExpression {
+	return newDecimalExpressionWrap(expression)
+}
@@ -0,0 +1,46 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	decimalTableColDecimal = DecimalColumn("col_decimal")
+	decimalTable           = NewTable("db", "decimal_table", "", decimalTableColDecimal)
+)
+
+func TestDecimalExpressionComparison(t *testing.T) {
+	assertClauseSerialize(t, decimalTableColDecimal.EQ(table1ColFloat), "(decimal_table.col_decimal = table1.col_float)")
+	assertClauseSerialize(t, decimalTableColDecimal.EQ(Decimal("1.11111111111111111111")),
+		"(decimal_table.col_decimal = $1)", "1.11111111111111111111")
+	assertClauseSerialize(t, decimalTableColDecimal.BETWEEN(Int(1), Float(2.5)),
+		"(decimal_table.col_decimal BETWEEN $1 AND $2)", int64(1), 2.5)
+	assertClauseSerialize(t, table1ColFloat.LT(FloatExp(Decimal("2.5"))), "(table1.col_float < $1)", "2.5")
+}
+
+func TestDecimalExpressionArithmetic(t *testing.T) {
+	exp := decimalTableColDecimal.ADD(Decimal("0.1")).MUL(decimalTableColDecimal)
+
+	assertClauseSerialize(t, exp, "((decimal_table.col_decimal + $1) * decimal_table.col_decimal)", "0.1")
+	require.Implements(t, (*DecimalExpression)(nil), exp)
+	require.Implements(t, (*DecimalExpression)(nil), decimalTableColDecimal.SUB(Int(1)))
+	require.Implements(t, (*DecimalExpression)(nil), decimalTableColDecimal.DIV(Float(1.5)))
+	require.Implements(t, (*DecimalExpression)(nil), decimalTableColDecimal.MOD(Int(3)))
+	require.NotImplements(t, (*DecimalExpression)(nil), table1ColFloat.ADD(Decimal("0.1")))
+	require.NotImplements(t, (*FloatExpression)(nil), exp)
+}
+
+func TestNewDecimalColumn(t *testing.T) {
+	decimalColumn := decimalTableColDecimal.From(subQuery)
+	assertClauseSerialize(t, decimalColumn, `sub_query."decimal_table.col_decimal"`)
+	assertClauseSerialize(t, decimalColumn.EQ(Decimal("12.30")), `(sub_query."decimal_table.col_decimal" = $1)`, "12.30")
+	assertProjectionSerialize(t, decimalColumn, `sub_query."decimal_table.col_decimal" AS "decimal_table.col_decimal"`)
+	require.Implements(t, (*DecimalExpression)(nil), decimalColumn)
+}
+
+func TestDecimalExp(t *testing.T) {
+	assertClauseSerialize(t, DecimalExp(table1ColFloat.ADD(Float(1))), "(table1.col_float + $1)", float64(1))
+	assertClauseSerialize(t, TypedExp[DecimalExpression](Raw("price * 1.2")), "(price * 1.2)")
+}
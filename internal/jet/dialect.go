@@ -10,9 +10,12 @@ type Dialect interface {
 	FunctionSerializeOverride(function string) SerializeOverride
 	AliasQuoteChar() byte
 	IdentifierQuoteChar() byte
+	IdentifierCloseQuoteChar() byte
 	ArgumentPlaceholder() QueryPlaceholderFunc
 	IsReservedWord(name string) bool
 	SerializeOrderBy() func(expression Expression, ascending, nullsFirst *bool) SerializerFunc
+	ExpandRowValueComparison() bool
+	Format() Format
 }
 
 // SerializerFunc func
@@ -32,9 +35,14 @@ type DialectParams struct {
 	FunctionSerializeOverrides map[string]SerializeOverride
 	AliasQuoteChar             byte
 	IdentifierQuoteChar        byte
+	IdentifierCloseQuoteChar   byte // if not set, IdentifierQuoteChar is used to close the quoted identifier
 	ArgumentPlaceholder        QueryPlaceholderFunc
 	ReservedWords              []string
 	SerializeOrderBy           func(expression Expression, ascending, nullsFirst *bool) SerializerFunc
+	// if set, row value comparisons generated by jet, like keyset pagination conditions, are expanded into
+	// the OR chain of the column comparisons, for the databases without row value support
+	ExpandRowValueComparison bool
+	Format                   Format
 }
 
 // NewDialect creates new dialect with params
@@ -46,12 +54,88 @@ func NewDialect(params DialectParams) Dialect {
 		functionSerializeOverrides: params.FunctionSerializeOverrides,
 		aliasQuoteChar:             params.AliasQuoteChar,
 		identifierQuoteChar:        params.IdentifierQuoteChar,
+		identifierCloseQuoteChar:   params.IdentifierCloseQuoteChar,
 		argumentPlaceholder:        params.ArgumentPlaceholder,
 		reservedWords:              arrayOfStringsToMapOfStrings(params.ReservedWords),
 		serializeOrderBy:           params.SerializeOrderBy,
+		expandRowValueComparison:   params.ExpandRowValueComparison,
+		format:                     params.Format,
 	}
 }
 
+// ExtendDialect creates new dialect from the base dialect. Params fields that are set override the base dialect
+// settings, while params operator and function overrides and reserved words are added to the base dialect ones.
+// Base dialect has to be created with NewDialect.
+func ExtendDialect(base Dialect, params DialectParams) Dialect {
+	baseImpl, ok := base.(*dialectImpl)
+
+	if !ok {
+		panic("jet: only dialects created with NewDialect can be extended")
+	}
+
+	ret := *baseImpl
+	ret.operatorSerializeOverrides = mergeSerializeOverrides(baseImpl.operatorSerializeOverrides, params.OperatorSerializeOverrides)
+	ret.functionSerializeOverrides = mergeSerializeOverrides(baseImpl.functionSerializeOverrides, params.FunctionSerializeOverrides)
+	ret.reservedWords = map[string]bool{}
+
+	for word := range baseImpl.reservedWords {
+		ret.reservedWords[word] = true
+	}
+
+	for word := range arrayOfStringsToMapOfStrings(params.ReservedWords) {
+		ret.reservedWords[word] = true
+	}
+
+	if params.Name != "" {
+		ret.name = params.Name
+	}
+
+	if params.PackageName != "" {
+		ret.packageName = params.PackageName
+	}
+
+	if params.AliasQuoteChar != 0 {
+		ret.aliasQuoteChar = params.AliasQuoteChar
+	}
+
+	if params.IdentifierQuoteChar != 0 {
+		ret.identifierQuoteChar = params.IdentifierQuoteChar
+		ret.identifierCloseQuoteChar = params.IdentifierCloseQuoteChar
+	}
+
+	if params.ArgumentPlaceholder != nil {
+		ret.argumentPlaceholder = params.ArgumentPlaceholder
+	}
+
+	if params.SerializeOrderBy != nil {
+		ret.serializeOrderBy = params.SerializeOrderBy
+	}
+
+	if params.ExpandRowValueComparison {
+		ret.expandRowValueComparison = true
+	}
+
+	if params.Format != (Format{}) {
+		ret.format = params.Format
+	}
+
+	return &ret
+}
+
+func mergeSerializeOverrides(base, overrides map[string]SerializeOverride) map[string]SerializeOverride {
+	ret := map[string]SerializeOverride{}
+
+	for name, override := range base {
+		ret[name] = override
+	}
+
+	for name, override := range overrides {
+		ret[name] = override
+	}
+
+	return ret
+}
+
 type dialectImpl struct {
 	name                       string
 	packageName                string
@@ -59,9 +143,12 @@ type dialectImpl struct {
 	functionSerializeOverrides map[string]SerializeOverride
 	aliasQuoteChar             byte
 	identifierQuoteChar        byte
+	identifierCloseQuoteChar   byte
 	argumentPlaceholder        QueryPlaceholderFunc
 	reservedWords              map[string]bool
 	serializeOrderBy           func(expression Expression, ascending, nullsFirst *bool) SerializerFunc
+	expandRowValueComparison   bool
+	format                     Format
 }
 
 func (d *dialectImpl) Name() string {
@@ -94,6 +181,13 @@ func (d *dialectImpl) IdentifierQuoteChar() byte {
 	return d.identifierQuoteChar
 }
 
+func (d *dialectImpl) IdentifierCloseQuoteChar() byte {
+	if d.identifierCloseQuoteChar == 0 {
+		return d.identifierQuoteChar
+	}
+	return d.identifierCloseQuoteChar
+}
+
 func (d *dialectImpl) ArgumentPlaceholder() QueryPlaceholderFunc {
 	return d.argumentPlaceholder
 }
@@ -107,6 +201,14 @@ func (d *dialectImpl) SerializeOrderBy() func(expression Expression, ascending,
 	return d.serializeOrderBy
 }
 
+func (d *dialectImpl) ExpandRowValueComparison() bool {
+	return d.expandRowValueComparison
+}
+
+func (d *dialectImpl) Format() Format {
+	return d.format
+}
+
 func arrayOfStringsToMapOfStrings(arr []string) map[string]bool {
 	ret := map[string]bool{}
 	for _, elem := range arr {
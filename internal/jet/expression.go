@@ -128,7 +128,7 @@ func (c *binaryOperatorExpression) serialize(statement StatementType, out *SQLBu
 		serializeOverrideFunc(statement, out, FallTrough(options)...)
 	} else {
 		c.lhs.serialize(statement, out, FallTrough(options)...)
-		out.WriteString(c.operator)
+		out.WriteKeyword(c.operator)
 		c.rhs.serialize(statement, out, FallTrough(options)...)
 	}
 }
@@ -173,7 +173,7 @@ func (elo *expressionListOperator) serialize(statement StatementType, out *SQLBu
 		}
 		if i > 0 {
 			out.NewLine()
-			out.WriteString(elo.operator)
+			out.WriteKeyword(elo.operator)
 		}
 
 		out.IncreaseIdent(len(elo.operator) + 1)
@@ -211,7 +211,7 @@ func newPrefixOperatorExpression(expression Expression, operator string) Express
 }
 
 func (p *prefixExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString(p.operator)
+	out.WriteKeyword(p.operator)
 	p.expression.serialize(statement, out, FallTrough(options)...)
 }
 
@@ -236,7 +236,7 @@ func newPostfixOperatorExpression(expression Expression, operator string) *postf
 
 func (p *postfixOpExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	p.expression.serialize(statement, out, FallTrough(options)...)
-	out.WriteString(p.operator)
+	out.WriteKeyword(p.operator)
 }
 
 type betweenOperatorExpression struct {
@@ -265,11 +265,11 @@ func NewBetweenOperatorExpression(expression, min, max Expression, notBetween bo
 func (p *betweenOperatorExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	p.expression.serialize(statement, out, FallTrough(options)...)
 	if p.notBetween {
-		out.WriteString("NOT")
+		out.WriteKeyword("NOT")
 	}
-	out.WriteString("BETWEEN")
+	out.WriteKeyword("BETWEEN")
 	p.min.serialize(statement, out, FallTrough(options)...)
-	out.WriteString("AND")
+	out.WriteKeyword("AND")
 	p.max.serialize(statement, out, FallTrough(options)...)
 }
 
@@ -292,6 +292,32 @@ func (c *customExpression) serialize(statement StatementType, out *SQLBuilder, o
 	}
 }
 
+type compositeFieldExpression struct {
+	ExpressionInterfaceImpl
+	composite Expression
+	field     string
+}
+
+// CompositeField creates composite type field access expression: (composite).field
+func CompositeField(composite Expression, field string) Expression {
+	ret := compositeFieldExpression{
+		composite: composite,
+		field:     field,
+	}
+	ret.ExpressionInterfaceImpl.Parent = &ret
+	return &ret
+}
+
+func (c *compositeFieldExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if c.composite == nil {
+		panic("jet: composite expression is nil")
+	}
+
+	out.WriteString("(")
+	c.composite.serialize(statement, out, NoWrap)
+	out.write([]byte(")." + out.identifier(c.field)))
+}
+
 type complexExpression struct {
 	ExpressionInterfaceImpl
 	expressions Expression
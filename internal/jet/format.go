@@ -0,0 +1,42 @@
+package jet
+
+import "strings"
+
+// KeywordCase is letter case of the SQL keywords in the serialized statements
+type KeywordCase int
+
+// List of keyword cases
+const (
+	UpperCaseKeywords KeywordCase = iota
+	LowerCaseKeywords
+)
+
+// Format is formatting of the serialized SQL statements. Zero value is default, pretty printed, format.
+type Format struct {
+	// Compact serializes statement in a single line, without indentation. Line comments, for instance from raw
+	// expressions, are still terminated with the new line.
+	Compact bool
+	// Indent is the number of spaces the clause lists are indented with. Nested indentations are scaled accordingly.
+	// If not set, default indent of 5 spaces is used.
+	Indent int
+	// KeywordCase is letter case of the keywords, operators and function names
+	KeywordCase KeywordCase
+}
+
+// indentSpaces returns the number of spaces for the ident level
+func (f Format) indentSpaces(ident int) int {
+	if f.Indent <= 0 {
+		return ident
+	}
+
+	return ident * f.Indent / defaultIdent
+}
+
+// keyword returns keyword in the format keyword case
+func (f Format) keyword(keyword string) string {
+	if f.KeywordCase != LowerCaseKeywords {
+		return keyword
+	}
+
+	return strings.ToLower(keyword)
+}
@@ -0,0 +1,55 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatKeyword(t *testing.T) {
+	require.Equal(t, "ORDER BY", Format{}.keyword("ORDER BY"))
+	require.Equal(t, "order by", Format{KeywordCase: LowerCaseKeywords}.keyword("ORDER BY"))
+}
+
+func TestLowerCaseKeywordsFunctionCall(t *testing.T) {
+	out := SQLBuilder{Dialect: defaultDialect, Debug: true, format: Format{KeywordCase: LowerCaseKeywords}}
+	CASE().WHEN(Bool(true)).THEN(NewCastImpl(Int(1)).AS("BIGINT")).serialize(SelectStatementType, &out)
+	EXTRACT("YEAR", Raw("NOW()")).serialize(SelectStatementType, &out)
+
+	require.Equal(t, `(case when true then cast(1 as bigint) end) extract(year from (NOW()))`, out.Buff.String())
+}
+
+func TestLowerCaseKeywordsVerbatimIdentifiers(t *testing.T) {
+	upperColumn := IntegerColumn("ID")
+	upperColumn.setTableName("USERS")
+
+	out := SQLBuilder{Dialect: defaultDialect, Debug: true, format: Format{KeywordCase: LowerCaseKeywords}}
+	out.WriteKeyword("WHERE")
+	upperColumn.EQ(IntExp(Raw("MY_CONST"))).serialize(SelectStatementType, &out)
+	out.WriteKeyword("AND")
+	Bool(true).serialize(SelectStatementType, &out)
+
+	require.Equal(t, `where ("USERS"."ID" = (MY_CONST)) and true`, out.Buff.String())
+}
+
+func TestFormatIndentSpaces(t *testing.T) {
+	require.Equal(t, 11, Format{}.indentSpaces(11))
+	require.Equal(t, 2, Format{Indent: 2}.indentSpaces(5))
+	require.Equal(t, 4, Format{Indent: 2}.indentSpaces(11))
+}
+
+func TestCompactFormatLineComment(t *testing.T) {
+	out := SQLBuilder{Dialect: defaultDialect, format: Format{Compact: true}}
+	out.WriteKeyword("SELECT")
+	out.WriteString("1 -- comment")
+	out.NewLine()
+	out.WriteKeyword("FROM")
+	out.WriteIdentifier("table1")
+	out.NewLine()
+	out.WriteKeyword("WHERE")
+	out.WriteString("true -- last comment")
+
+	query, _ := out.finalize()
+
+	require.Equal(t, "SELECT 1 -- comment\nFROM table1 WHERE true -- last comment\n;", query)
+}
@@ -0,0 +1,123 @@
+package jet
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// TypedExp is expression wrapper around arbitrary expression, that allows go compiler to see any expression as
+// expression of type T. T has to be one of the jet expression interfaces.
+// Does not add sql cast to generated sql builder output.
+func TypedExp[T Expression](expression Expression) T {
+	var typed Expression
+
+	switch any((*T)(nil)).(type) {
+	case *Expression:
+		typed = expression
+	case *BoolExpression:
+		typed = BoolExp(expression)
+	case *IntegerExpression, *Int4Expression, *Int8Expression:
+		typed = IntExp(expression)
+	case *FloatExpression, *NumericExpression:
+		typed = FloatExp(expression)
+	case *DecimalExpression:
+		typed = DecimalExp(expression)
+	case *StringExpression:
+		typed = StringExp(expression)
+	case *DateExpression:
+		typed = DateExp(expression)
+	case *TimeExpression:
+		typed = TimeExp(expression)
+	case *TimezExpression:
+		typed = TimezExp(expression)
+	case *TimestampExpression:
+		typed = TimestampExp(expression)
+	case *TimestampzExpression:
+		typed = TimestampzExp(expression)
+	case *Range[Int4Expression]:
+		typed = Int4RangeExp(expression)
+	case *Range[Int8Expression]:
+		typed = Int8RangeExp(expression)
+	case *Range[NumericExpression]:
+		typed = NumRangeExp(expression)
+	case *Range[DateExpression]:
+		typed = DateRangeExp(expression)
+	case *Range[TimestampExpression]:
+		typed = TsRangeExp(expression)
+	case *Range[TimestampzExpression]:
+		typed = TstzRangeExp(expression)
+	default:
+		typed = expression
+
+		if constructor, ok := typedExpConstructors[reflect.TypeOf((*T)(nil)).Elem()]; ok {
+			typed = constructor(expression)
+		}
+	}
+
+	ret, ok := typed.(T)
+
+	if !ok {
+		panic(fmt.Sprintf("jet: unsupported expression type %T", (*T)(nil)))
+	}
+
+	return ret
+}
+
+var typedExpConstructors = map[reflect.Type]func(Expression) Expression{}
+
+// RegisterTypedExp registers constructor of the dialect expression type T, that is not known to jet package (for
+// instance postgres interval expression), so that TypedExp and function declarations can return expressions of type T.
+// It should be called only from the dialect package init function.
+func RegisterTypedExp[T Expression](constructor func(Expression) T) {
+	typedExpConstructors[reflect.TypeOf((*T)(nil)).Elem()] = func(expression Expression) Expression {
+		return constructor(expression)
+	}
+}
+
+// DeclareFunc0 declares database function without parameters, returning expression of type R.
+func DeclareFunc0[R Expression](name string) func() R {
+	return func() R {
+		return TypedExp[R](Func(name))
+	}
+}
+
+// DeclareFunc1 declares database function with one parameter, returning expression of type R.
+func DeclareFunc1[A1, R Expression](name string) func(A1) R {
+	return func(arg1 A1) R {
+		return TypedExp[R](Func(name, arg1))
+	}
+}
+
+// DeclareFunc2 declares database function with two parameters, returning expression of type R.
+func DeclareFunc2[A1, A2, R Expression](name string) func(A1, A2) R {
+	return func(arg1 A1, arg2 A2) R {
+		return TypedExp[R](Func(name, arg1, arg2))
+	}
+}
+
+// DeclareFunc3 declares database function with three parameters, returning expression of type R.
+func DeclareFunc3[A1, A2, A3, R Expression](name string) func(A1, A2, A3) R {
+	return func(arg1 A1, arg2 A2, arg3 A3) R {
+		return TypedExp[R](Func(name, arg1, arg2, arg3))
+	}
+}
+
+// DeclareFunc4 declares database function with four parameters, returning expression of type R.
+func DeclareFunc4[A1, A2, A3, A4, R Expression](name string) func(A1, A2, A3, A4) R {
+	return func(arg1 A1, arg2 A2, arg3 A3, arg4 A4) R {
+		return TypedExp[R](Func(name, arg1, arg2, arg3, arg4))
+	}
+}
+
+// DeclareFuncN declares variadic database function with parameters of the same type, returning expression of type R.
+func DeclareFuncN[A, R Expression](name string) func(...A) R {
+	return func(args ...A) R {
+		var expressions []Expression
+
+		for _, arg := range args {
+			expressions = append(expressions, arg)
+		}
+
+		return TypedExp[R](Func(name, expressions...))
+	}
+}
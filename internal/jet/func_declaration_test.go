@@ -0,0 +1,48 @@
+package jet
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypedExp(t *testing.T) {
+	var _ = TypedExp[BoolExpression](table1ColInt).IS_TRUE()
+	var _ = TypedExp[Int4Expression](table1ColFloat).ADD(Int(1))
+	var _ = TypedExp[Range[Int8Expression]](table1ColInt).UPPER_BOUND()
+
+	assertClauseSerialize(t, TypedExp[StringExpression](table1ColInt).CONCAT(String("str")), "(table1.col_int || $1)", "str")
+	assertClauseSerialize(t, TypedExp[Expression](table1ColInt), "table1.col_int")
+	assertClauseSerialize(t, TypedExp[IntegerExpression](table1ColInt), "table1.col_int")
+}
+
+func TestTypedExpUnsupported(t *testing.T) {
+	type customExpression interface {
+		Expression
+		CUSTOM() Expression
+	}
+
+	require.PanicsWithValue(t, "jet: unsupported expression type *jet.customExpression", func() {
+		TypedExp[customExpression](table1ColInt)
+	})
+}
+
+func TestDeclareFunc(t *testing.T) {
+	random := DeclareFunc0[FloatExpression]("random")
+	similarity := DeclareFunc2[StringExpression, StringExpression, FloatExpression]("similarity")
+	dateAdd := DeclareFunc3[DateExpression, IntegerExpression, StringExpression, DateExpression]("date_add")
+	greatest := DeclareFuncN[IntegerExpression, IntegerExpression]("greatest")
+
+	assertClauseSerialize(t, random().ADD(Float(1.5)), "(random() + $1)", 1.5)
+	assertClauseSerialize(t, similarity(table2ColStr, String("word")).GT(Float(0.3)),
+		"(similarity(table2.col_str, $1) > $2)", "word", 0.3)
+	assertClauseSerialize(t, dateAdd(table1ColDate, Int(2), String("day")), "date_add(table1.col_date, $1, $2)",
+		int64(2), "day")
+	assertClauseSerialize(t, greatest(table1ColInt, table2ColInt, Int(3)), "greatest(table1.col_int, table2.col_int, $1)",
+		int64(3))
+	assertClauseSerialize(t, DeclareFunc1[Expression, BoolExpression]("is_valid")(table1Col1).IS_FALSE(),
+		"is_valid(table1.col1) IS FALSE")
+	assertClauseSerialize(t, DeclareFunc4[IntegerExpression, IntegerExpression, IntegerExpression, IntegerExpression,
+		IntegerExpression]("sum4")(Int(1), Int(2), Int(3), Int(4)), "sum4($1, $2, $3, $4)",
+		int64(1), int64(2), int64(3), int64(4))
+}
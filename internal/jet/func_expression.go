@@ -548,7 +548,7 @@ func TO_TIMESTAMP(timestampzStr, format StringExpression) TimestampzExpression {
 
 // EXTRACT extracts time component from time expression
 func EXTRACT(field string, from Expression) Expression {
-	return CustomExpression(Token("EXTRACT("), Token(field), Token("FROM"), from, Token(")"))
+	return CustomExpression(functionCall("EXTRACT"), Keyword(field), Keyword("FROM"), from, Token(")"))
 }
 
 // CURRENT_DATE returns current date
@@ -687,9 +687,9 @@ func (f *funcExpressionImpl) serialize(statement StatementType, out *SQLBuilder,
 	addBrackets := !f.noBrackets || len(f.parameters) > 0
 
 	if addBrackets {
-		out.WriteString(f.name + "(")
+		out.WriteFunctionCall(f.name)
 	} else {
-		out.WriteString(f.name)
+		out.WriteKeyword(f.name)
 	}
 
 	f.parameters.serialize(statement, out, options...)
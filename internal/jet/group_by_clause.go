@@ -36,6 +36,6 @@ func GROUPING(expressions ...Expression) IntegerExpression {
 // It creates extra rows in the result set that represent the subtotal values for each combination of columns.
 func WITH_ROLLUP(expressions ...Expression) GroupByClause {
 	return CustomExpression(
-		parametersSerializer(expressions), Token("WITH ROLLUP"),
+		parametersSerializer(expressions), Keyword("WITH ROLLUP"),
 	)
 }
@@ -0,0 +1,170 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/utils/must"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// ExecutionType is the type of statement execution
+type ExecutionType string
+
+// List of execution types
+const (
+	// QueryExecution is statement execution using Query or QueryContext methods
+	QueryExecution ExecutionType = "QUERY"
+	// ExecExecution is statement execution using Exec or ExecContext methods
+	ExecExecution ExecutionType = "EXEC"
+	// RowsExecution is statement execution using Rows method
+	RowsExecution ExecutionType = "ROWS"
+)
+
+// Execution contains information about the statement being executed. Execution is passed through
+// the interceptor chain, and interceptors can modify Query and Args before the statement is executed.
+// Query modifications are ignored for prepared statements.
+type Execution struct {
+	Type      ExecutionType
+	Statement PrintableStatement
+	Query     string
+	Args      []interface{}
+	// Destination is the query result mapping destination. Set only for QueryExecution.
+	Destination interface{}
+
+	// Depending on execution type, after statement execution RowsProcessed is:
+	// 	- Number of rows returned for QueryExecution
+	// 	- RowsAffected() for ExecExecution
+	// 	- Always 0 for RowsExecution
+	RowsProcessed int64
+	// Result of the ExecExecution. Interceptors short-circuiting the execution should set Result.
+	Result sql.Result
+	// Rows of the RowsExecution. Interceptors short-circuiting the execution should set Rows.
+	Rows *sql.Rows
+}
+
+// ExecutionHandler executes the statement described by execution
+type ExecutionHandler func(ctx context.Context, execution *Execution) error
+
+// Interceptor intercepts statement execution. Interceptor should call next handler to continue the execution.
+// Interceptor can run code before and after the next handler, modify execution query and arguments, short-circuit
+// the execution by not calling the next handler, or wrap the returned error.
+type Interceptor func(ctx context.Context, execution *Execution, next ExecutionHandler) error
+
+// InterceptedDB is a database connection or transaction with the list of statement execution interceptors.
+// Interceptors are called for every statement executed over InterceptedDB.
+type InterceptedDB struct {
+	qrm.DB
+
+	interceptors []Interceptor
+}
+
+// WithInterceptors wraps database connection or transaction db with the list of statement execution interceptors.
+// If db is already InterceptedDB, new interceptors are appended to the existing ones.
+func WithInterceptors(db qrm.DB, interceptors ...Interceptor) *InterceptedDB {
+	must.BeInitializedPtr(db, "jet: db is nil")
+
+	if interceptedDB, ok := db.(*InterceptedDB); ok {
+		return &InterceptedDB{
+			DB:           interceptedDB.DB,
+			interceptors: appendInterceptors(interceptedDB.interceptors, interceptors),
+		}
+	}
+
+	return &InterceptedDB{
+		DB:           db,
+		interceptors: interceptors,
+	}
+}
+
+// Unwrap returns wrapped database connection or transaction
+func (i *InterceptedDB) Unwrap() qrm.DB {
+	return i.DB
+}
+
+// PrepareContext creates a prepared statement, if wrapped database connection or transaction supports it.
+// Interceptors are not called for the statement preparation.
+func (i *InterceptedDB) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	preparable, ok := i.DB.(qrm.Preparable)
+
+	if !ok {
+		return nil, errors.New("jet: wrapped db does not support prepared statements")
+	}
+
+	return preparable.PrepareContext(ctx, query)
+}
+
+type interceptorsContextKey struct{}
+
+// ContextWithInterceptors returns a copy of ctx carrying the list of statement execution interceptors.
+// Interceptors are called for every statement executed with the returned context, after the InterceptedDB interceptors.
+func ContextWithInterceptors(ctx context.Context, interceptors ...Interceptor) context.Context {
+	return context.WithValue(ctx, interceptorsContextKey{}, appendInterceptors(contextInterceptors(ctx), interceptors))
+}
+
+func contextInterceptors(ctx context.Context) []Interceptor {
+	if ctx == nil {
+		return nil
+	}
+
+	interceptors, _ := ctx.Value(interceptorsContextKey{}).([]Interceptor)
+
+	return interceptors
+}
+
+// QueryLoggerInterceptor returns interceptor which calls loggerFunc after each statement execution.
+// Unlike SetQueryLogger, the logger is not global, and can be attached only to a specific database or context.
+func QueryLoggerInterceptor(loggerFunc QueryLoggerFunc) Interceptor {
+	return func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+		var err error
+
+		duration := duration(func() {
+			err = next(ctx, execution)
+		})
+
+		loggerFunc(ctx, QueryInfo{
+			Statement:     execution.Statement,
+			RowsProcessed: execution.RowsProcessed,
+			Duration:      duration,
+			Err:           err,
+		})
+
+		return err
+	}
+}
+
+func dbInterceptors(db interface{}) []Interceptor {
+	if interceptedDB, ok := db.(*InterceptedDB); ok {
+		return interceptedDB.interceptors
+	}
+
+	return nil
+}
+
+// intercept executes the statement through the chain of db and context interceptors
+func intercept(ctx context.Context, dbInterceptors []Interceptor, execution *Execution, handler ExecutionHandler) (time.Duration, error) {
+	interceptors := appendInterceptors(dbInterceptors, contextInterceptors(ctx))
+
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, next := interceptors[i], handler
+
+		handler = func(ctx context.Context, execution *Execution) error {
+			return interceptor(ctx, execution, next)
+		}
+	}
+
+	var err error
+
+	return duration(func() {
+		err = handler(ctx, execution)
+	}), err
+}
+
+func appendInterceptors(interceptors []Interceptor, newInterceptors []Interceptor) []Interceptor {
+	ret := make([]Interceptor, 0, len(interceptors)+len(newInterceptors))
+	ret = append(ret, interceptors...)
+
+	return append(ret, newInterceptors...)
+}
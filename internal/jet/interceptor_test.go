@@ -0,0 +1,150 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type execRecorderDB struct {
+	query string
+	args  []interface{}
+	err   error
+}
+
+func (db *execRecorderDB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return db.ExecContext(context.Background(), query, args...)
+}
+
+func (db *execRecorderDB) ExecContext(_ context.Context, query string, args ...interface{}) (sql.Result, error) {
+	db.query, db.args = query, args
+
+	if db.err != nil {
+		return nil, db.err
+	}
+
+	return driver.RowsAffected(3), nil
+}
+
+func (db *execRecorderDB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return db.QueryContext(context.Background(), query, args...)
+}
+
+func (db *execRecorderDB) QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("not supported")
+}
+
+func newDeleteStatement() ConfigurableStatement {
+	return RawStatement(defaultDialect, "DELETE FROM db.table WHERE id = #id", map[string]interface{}{"#id": 11})
+}
+
+func TestInterceptorChainOrder(t *testing.T) {
+	var calls []string
+
+	tracer := func(name string) Interceptor {
+		return func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+			calls = append(calls, "before "+name)
+			err := next(ctx, execution)
+			calls = append(calls, fmt.Sprintf("after %s %d", name, execution.RowsProcessed))
+			return err
+		}
+	}
+
+	db := WithInterceptors(WithInterceptors(&execRecorderDB{}, tracer("db1")), tracer("db2"))
+	ctx := ContextWithInterceptors(context.Background(), tracer("ctx"))
+
+	res, err := newDeleteStatement().ExecContext(ctx, db)
+	require.NoError(t, err)
+	rowsAffected, _ := res.RowsAffected()
+	require.Equal(t, int64(3), rowsAffected)
+
+	require.Equal(t, []string{
+		"before db1", "before db2", "before ctx",
+		"after ctx 3", "after db2 3", "after db1 3",
+	}, calls)
+}
+
+func TestInterceptorModifyArgs(t *testing.T) {
+	recorder := &execRecorderDB{}
+
+	db := WithInterceptors(recorder, func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+		require.Equal(t, ExecExecution, execution.Type)
+		require.Equal(t, "DELETE FROM db.table WHERE id = $1;\n", execution.Query)
+		require.Equal(t, []interface{}{11}, execution.Args)
+
+		execution.Query = "/* audit */ " + execution.Query
+		execution.Args = []interface{}{22}
+
+		return next(ctx, execution)
+	})
+
+	_, err := newDeleteStatement().Exec(db)
+	require.NoError(t, err)
+	require.Equal(t, "/* audit */ DELETE FROM db.table WHERE id = $1;\n", recorder.query)
+	require.Equal(t, []interface{}{22}, recorder.args)
+}
+
+func TestInterceptorShortCircuit(t *testing.T) {
+	type Dest struct {
+		ID int
+	}
+
+	cache := func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+		execution.Destination.(*Dest).ID = 42
+		execution.RowsProcessed = 1
+		return nil
+	}
+
+	var rowsProcessed int64
+
+	ctx := ContextWithInterceptors(context.Background(), QueryLoggerInterceptor(func(ctx context.Context, info QueryInfo) {
+		rowsProcessed = info.RowsProcessed
+	}))
+
+	var dest Dest
+	err := newDeleteStatement().QueryContext(ctx, WithInterceptors(&execRecorderDB{}, cache), &dest)
+	require.NoError(t, err)
+	require.Equal(t, 42, dest.ID)
+	require.Equal(t, int64(0), rowsProcessed) // context interceptors are not called after short-circuit
+
+	interrupt := func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+		return nil
+	}
+
+	_, err = newDeleteStatement().Rows(context.Background(), WithInterceptors(&execRecorderDB{}, interrupt))
+	require.EqualError(t, err, "jet: statement execution has been interrupted without rows")
+}
+
+func TestInterceptorWrapError(t *testing.T) {
+	dbErr := errors.New("connection refused")
+
+	db := WithInterceptors(&execRecorderDB{err: dbErr}, func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+		if err := next(ctx, execution); err != nil {
+			return fmt.Errorf("audit: %w", err)
+		}
+		return nil
+	})
+
+	var queryInfo QueryInfo
+
+	ctx := ContextWithInterceptors(context.Background(), QueryLoggerInterceptor(func(ctx context.Context, info QueryInfo) {
+		queryInfo = info
+	}))
+
+	_, err := newDeleteStatement().ExecContext(ctx, db)
+	require.EqualError(t, err, "audit: connection refused")
+	require.True(t, errors.Is(err, dbErr))
+	require.Equal(t, dbErr, queryInfo.Err)
+	require.Equal(t, "DELETE FROM db.table WHERE id = 11;\n", queryInfo.Statement.DebugSql())
+}
+
+func TestWithInterceptorsNilDB(t *testing.T) {
+	require.PanicsWithValue(t, "jet: db is nil", func() {
+		WithInterceptors(nil)
+	})
+}
@@ -32,6 +32,6 @@ type IntervalImpl struct {
 }
 
 func (i IntervalImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString("INTERVAL")
+	out.WriteKeyword("INTERVAL")
 	i.Value.serialize(statement, out, FallTrough(options)...)
 }
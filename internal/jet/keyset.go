@@ -0,0 +1,344 @@
+package jet
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// KeysetCondition returns keyset pagination condition, matching the rows ordered after the row with the last-seen
+// values of the order by expressions. If all the order by clauses have the same direction and unspecified NULLS
+// ordering, condition is row value comparison, for instance (a, b) > ($1, $2). Otherwise, or if the statement dialect
+// expands row value comparisons, condition is expanded into the OR chain of the comparisons, for instance
+// a > $1 OR (a = $1 AND b > $2). Order by expressions with unspecified NULLS ordering are expected to be not null.
+func KeysetCondition(orderBy []OrderByClause, lastValues []interface{}) BoolExpression {
+	if len(orderBy) == 0 {
+		panic("jet: keyset pagination requires at least one ORDER BY clause")
+	}
+
+	if len(orderBy) != len(lastValues) {
+		panic(fmt.Sprintf("jet: keyset pagination expects %d last values, got %d", len(orderBy), len(lastValues)))
+	}
+
+	keys := make([]keysetKey, len(orderBy))
+
+	for i, clause := range orderBy {
+		keys[i] = newKeysetKey(clause, lastValues[i])
+	}
+
+	if len(keys) == 1 && isRowValueKeyset(keys) {
+		return keys[0].after()
+	}
+
+	expanded := expandedKeysetCondition(keys)
+
+	if !isRowValueKeyset(keys) {
+		return expanded
+	}
+
+	var columns, values []Expression
+
+	for _, key := range keys {
+		columns = append(columns, key.expression)
+		values = append(values, key.value)
+	}
+
+	rowValue := Gt(WRAP(columns...), WRAP(values...))
+
+	if !keys[0].ascending {
+		rowValue = Lt(WRAP(columns...), WRAP(values...))
+	}
+
+	return newKeysetCondition(rowValue, expanded)
+}
+
+// expandedKeysetCondition returns keyset condition as the OR chain of the comparisons
+func expandedKeysetCondition(keys []keysetKey) BoolExpression {
+	var conditions []BoolExpression
+
+	for i, key := range keys {
+		after := key.after()
+
+		if after == nil { // there are no rows after NULL value, with the NULLS LAST ordering
+			continue
+		}
+
+		for j := i - 1; j >= 0; j-- {
+			after = keys[j].equal().AND(after)
+		}
+
+		conditions = append(conditions, after)
+	}
+
+	if len(conditions) == 0 {
+		return Bool(false)
+	}
+
+	condition := conditions[0]
+
+	for _, c := range conditions[1:] {
+		condition = condition.OR(c)
+	}
+
+	return condition
+}
+
+// keysetCondition serializes row value keyset condition, or expanded keyset condition if the dialect expands row
+// value comparisons
+type keysetCondition struct {
+	ExpressionInterfaceImpl
+
+	rowValue BoolExpression
+	expanded BoolExpression
+}
+
+func newKeysetCondition(rowValue, expanded BoolExpression) BoolExpression {
+	ret := &keysetCondition{
+		rowValue: rowValue,
+		expanded: expanded,
+	}
+	ret.ExpressionInterfaceImpl.Parent = ret
+
+	return BoolExp(ret)
+}
+
+func (k *keysetCondition) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.Dialect.ExpandRowValueComparison() {
+		k.expanded.serialize(statement, out, options...)
+		return
+	}
+
+	k.rowValue.serialize(statement, out, options...)
+}
+
+type keysetKey struct {
+	expression Expression
+	ascending  bool
+	nullsFirst *bool
+	value      Expression // nil if the last value is NULL
+}
+
+func newKeysetKey(clause OrderByClause, lastValue interface{}) keysetKey {
+	var key keysetKey
+
+	switch c := clause.(type) {
+	case *orderByClauseImpl:
+		key.expression = c.expression
+		key.ascending = c.ascending == nil || *c.ascending
+		key.nullsFirst = c.nullsFirst
+	case Expression:
+		key.expression = c
+		key.ascending = true
+	}
+
+	if key.expression == nil {
+		panic("jet: nil expression in keyset pagination ORDER BY clause")
+	}
+
+	if !isNil(lastValue) {
+		key.value = literal(lastValue)
+	} else if key.nullsFirst == nil {
+		panic("jet: keyset pagination last value is NULL, but ORDER BY clause NULLS ordering is not specified")
+	}
+
+	return key
+}
+
+// after returns condition matching the rows ordered after the last value, or nil if there are no such rows
+func (k keysetKey) after() BoolExpression {
+	if k.value == nil {
+		if *k.nullsFirst {
+			return k.expression.IS_NOT_NULL()
+		}
+		return nil
+	}
+
+	var after BoolExpression
+
+	if k.ascending {
+		after = Gt(k.expression, k.value)
+	} else {
+		after = Lt(k.expression, k.value)
+	}
+
+	if k.nullsFirst != nil && !*k.nullsFirst {
+		return after.OR(k.expression.IS_NULL())
+	}
+
+	return after
+}
+
+func (k keysetKey) equal() BoolExpression {
+	if k.value == nil {
+		return k.expression.IS_NULL()
+	}
+
+	return Eq(k.expression, k.value)
+}
+
+func isRowValueKeyset(keys []keysetKey) bool {
+	for _, key := range keys {
+		if key.nullsFirst != nil || key.ascending != keys[0].ascending {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	v := reflect.ValueOf(value)
+
+	return v.Kind() == reflect.Ptr && v.IsNil()
+}
+
+// ErrInvalidCursor is returned by DecodeCursor if the cursor is malformed
+var ErrInvalidCursor = errors.New("jet: invalid cursor")
+
+type cursorValue struct {
+	Type  string          `json:"t"`
+	Value json.RawMessage `json:"v,omitempty"`
+}
+
+// EncodeCursor encodes the last-seen values of the order by expressions into opaque URL safe cursor string.
+// Supported values are nil, bool, integers, floats, string, []byte, time.Time, pointers to them and driver.Valuer
+// values.
+func EncodeCursor(lastValues ...interface{}) (string, error) {
+	var values []cursorValue
+
+	for _, lastValue := range lastValues {
+		value, err := newCursorValue(lastValue)
+
+		if err != nil {
+			return "", err
+		}
+
+		values = append(values, value)
+	}
+
+	data, err := json.Marshal(values)
+
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func newCursorValue(value interface{}) (cursorValue, error) {
+	if valuer, ok := value.(driver.Valuer); ok && !isNil(value) {
+		var err error
+		value, err = valuer.Value()
+
+		if err != nil {
+			return cursorValue{}, err
+		}
+	}
+
+	if isNil(value) {
+		return cursorValue{Type: "null"}, nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(value))
+
+	var valueType string
+
+	switch v.Kind() {
+	case reflect.Bool:
+		valueType = "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		valueType = "int"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		valueType = "uint"
+	case reflect.Float32, reflect.Float64:
+		valueType = "float"
+	case reflect.String:
+		valueType = "string"
+	default:
+		switch v.Interface().(type) {
+		case []byte:
+			valueType = "bytes"
+		case time.Time:
+			valueType = "time"
+		default:
+			return cursorValue{}, fmt.Errorf("jet: unsupported cursor value type %T", value)
+		}
+	}
+
+	data, err := json.Marshal(v.Interface())
+
+	if err != nil {
+		return cursorValue{}, err
+	}
+
+	return cursorValue{Type: valueType, Value: data}, nil
+}
+
+// DecodeCursor decodes the cursor created with EncodeCursor into the list of last-seen values. Integers are decoded
+// as int64, unsigned integers as uint64, floats as float64 and driver.Valuer values as their driver values.
+func DecodeCursor(cursor string) ([]interface{}, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+
+	var values []cursorValue
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+	}
+
+	var ret []interface{}
+
+	for _, value := range values {
+		decoded, err := value.decode()
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err)
+		}
+
+		ret = append(ret, decoded)
+	}
+
+	return ret, nil
+}
+
+func (c cursorValue) decode() (interface{}, error) {
+	var dest interface{}
+
+	switch c.Type {
+	case "null":
+		return nil, nil
+	case "bool":
+		dest = new(bool)
+	case "int":
+		dest = new(int64)
+	case "uint":
+		dest = new(uint64)
+	case "float":
+		dest = new(float64)
+	case "string":
+		dest = new(string)
+	case "bytes":
+		dest = new([]byte)
+	case "time":
+		dest = new(time.Time)
+	default:
+		return nil, fmt.Errorf("unknown value type '%s'", c.Type)
+	}
+
+	if err := json.Unmarshal(c.Value, dest); err != nil {
+		return nil, err
+	}
+
+	return reflect.ValueOf(dest).Elem().Interface(), nil
+}
@@ -0,0 +1,70 @@
+package jet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeysetCondition(t *testing.T) {
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt}, []interface{}{1}),
+		"(table1.col_int > $1)", 1)
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt.ASC(), table1ColFloat.ASC()}, []interface{}{1, 2.5}),
+		"((table1.col_int, table1.col_float) > ($1, $2))", 1, 2.5)
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt.DESC(), table1ColFloat.DESC()}, []interface{}{1, 2.5}),
+		"((table1.col_int, table1.col_float) < ($1, $2))", 1, 2.5)
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt.DESC(), table1ColFloat.ASC()}, []interface{}{1, 2.5}),
+		"((table1.col_int < $1) OR ((table1.col_int = $2) AND (table1.col_float > $3)))", 1, 1, 2.5)
+}
+
+func TestKeysetConditionExpandedRowValue(t *testing.T) {
+	dialect := ExtendDialect(defaultDialect, DialectParams{ExpandRowValueComparison: true})
+	condition := table1ColBool.IS_TRUE().AND(
+		KeysetCondition([]OrderByClause{table1ColInt.ASC(), table1ColFloat.ASC()}, []interface{}{1, 2.5}),
+	)
+
+	out := SQLBuilder{Dialect: dialect}
+	condition.serialize(SelectStatementType, &out)
+
+	require.Equal(t, "(table1.col_bool IS TRUE AND ((table1.col_int > $1) OR ((table1.col_int = $2) AND (table1.col_float > $3))))", out.Buff.String())
+	require.Equal(t, []interface{}{1, 1, 2.5}, out.Args)
+
+	assertClauseSerialize(t, condition, "(table1.col_bool IS TRUE AND ((table1.col_int, table1.col_float) > ($1, $2)))", 1, 2.5)
+}
+
+func TestKeysetConditionNulls(t *testing.T) {
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt.ASC().NULLS_LAST(), table1ColFloat}, []interface{}{1, 2.5}),
+		"(((table1.col_int > $1) OR table1.col_int IS NULL) OR ((table1.col_int = $2) AND (table1.col_float > $3)))", 1, 1, 2.5)
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt.ASC().NULLS_LAST(), table1ColFloat}, []interface{}{nil, 2.5}),
+		"(table1.col_int IS NULL AND (table1.col_float > $1))", 2.5)
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt.DESC().NULLS_FIRST(), table1ColFloat}, []interface{}{nil, 2.5}),
+		"(table1.col_int IS NOT NULL OR (table1.col_int IS NULL AND (table1.col_float > $1)))", 2.5)
+	assertClauseSerialize(t, KeysetCondition([]OrderByClause{table1ColInt.ASC().NULLS_LAST()}, []interface{}{nil}),
+		"$1", false)
+
+	require.PanicsWithValue(t, "jet: keyset pagination last value is NULL, but ORDER BY clause NULLS ordering is not specified", func() {
+		KeysetCondition([]OrderByClause{table1ColInt}, []interface{}{nil})
+	})
+	require.PanicsWithValue(t, "jet: keyset pagination expects 2 last values, got 1", func() {
+		KeysetCondition([]OrderByClause{table1ColInt, table1ColFloat}, []interface{}{1})
+	})
+}
+
+func TestCursor(t *testing.T) {
+	timestamp := time.Date(2024, 3, 4, 10, 20, 30, 500, time.UTC)
+	str := "str"
+
+	cursor, err := EncodeCursor(int32(12), uint(3), 1.5, "text", &str, nil, (*string)(nil), true, []byte("bytes"), timestamp)
+	require.NoError(t, err)
+
+	values, err := DecodeCursor(cursor)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(12), uint64(3), 1.5, "text", "str", nil, nil, true, []byte("bytes"), timestamp}, values)
+
+	_, err = EncodeCursor(struct{}{})
+	require.EqualError(t, err, "jet: unsupported cursor value type struct {}")
+
+	_, err = DecodeCursor("not a cursor")
+	require.ErrorIs(t, err, ErrInvalidCursor)
+}
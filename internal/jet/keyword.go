@@ -9,5 +9,12 @@ const (
 type Keyword string
 
 func (k Keyword) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString(string(k))
+	out.WriteKeyword(string(k))
+}
+
+// functionCall is function name keyword, followed by the opening bracket of the function arguments
+type functionCall string
+
+func (f functionCall) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.WriteFunctionCall(string(f))
 }
@@ -47,6 +47,8 @@ func FixedLiteral(value interface{}) *literalExpressionImpl {
 }
 
 func (l *literalExpressionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.visit(Node{Kind: LiteralNode, Value: l.value})
+
 	if l.constant {
 		out.insertConstantArgument(l.value)
 	} else {
@@ -150,14 +152,21 @@ func Float(value float64) FloatExpression {
 	return &floatLiteral
 }
 
-// Decimal creates new float literal from string value
-func Decimal(value string) FloatExpression {
-	floatLiteral := floatLiteral{}
-	floatLiteral.literalExpressionImpl = *literal(value)
+// ---------------------------------------------------//
+type decimalLiteral struct {
+	decimalInterfaceImpl
+	literalExpressionImpl
+}
 
-	floatLiteral.floatInterfaceImpl.parent = &floatLiteral
+// Decimal creates new decimal literal from string value. Value is passed to the database as a string,
+// so there is no loss of precision.
+func Decimal(value string) DecimalExpression {
+	decimalLiteral := decimalLiteral{}
+	decimalLiteral.literalExpressionImpl = *literal(value)
 
-	return &floatLiteral
+	decimalLiteral.decimalInterfaceImpl.parent = &decimalLiteral
+
+	return &decimalLiteral
 }
 
 // ---------------------------------------------------//
@@ -352,7 +361,7 @@ func newNullLiteral() Expression {
 }
 
 func (n *nullLiteral) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString("NULL")
+	out.WriteKeyword("NULL")
 }
 
 // --------------------------------------------------//
@@ -369,6 +378,12 @@ func newStarLiteral() Expression {
 }
 
 func (n *starLiteral) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if out.allColumnsQualifier != "" {
+		out.WriteString(out.allColumnsQualifier)
+		out.WriteString(".*")
+		return
+	}
+
 	out.WriteString("*")
 }
 
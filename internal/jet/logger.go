@@ -23,7 +23,8 @@ func SetLoggerFunc(loggerFunc LoggerFunc) {
 	logger = loggerFunc
 }
 
-func callLogger(ctx context.Context, statement Statement) {
+// CallLogger calls automatic statement logging function, if set
+func CallLogger(ctx context.Context, statement PrintableStatement) {
 	if logger != nil {
 		logger(ctx, statement)
 	}
@@ -46,12 +47,14 @@ type QueryLoggerFunc func(ctx context.Context, info QueryInfo)
 
 var queryLoggerFunc QueryLoggerFunc
 
-// SetQueryLogger sets automatic query logging function.
+// SetQueryLogger sets automatic query logging function. Query logger is global, for the database or context
+// specific query logging use QueryLoggerInterceptor instead.
 func SetQueryLogger(loggerFunc QueryLoggerFunc) {
 	queryLoggerFunc = loggerFunc
 }
 
-func callQueryLoggerFunc(ctx context.Context, info QueryInfo) {
+// CallQueryLogger calls automatic query logging function, if set
+func CallQueryLogger(ctx context.Context, info QueryInfo) {
 	if queryLoggerFunc != nil {
 		queryLoggerFunc(ctx, info)
 	}
@@ -71,7 +74,7 @@ func (q QueryInfo) Caller() (file string, line int, function string) {
 		}
 
 		funcDetails := runtime.FuncForPC(pc)
-		if !strings.Contains(funcDetails.Name(), "github.com/go-jet/jet/v2/internal") {
+		if !isJetInternalFunction(funcDetails.Name()) {
 			function = funcDetails.Name()
 			return
 		}
@@ -79,3 +82,8 @@ func (q QueryInfo) Caller() (file string, line int, function string) {
 		skip++
 	}
 }
+
+func isJetInternalFunction(name string) bool {
+	return strings.Contains(name, "github.com/go-jet/jet/v2/internal") ||
+		strings.Contains(name, "github.com/go-jet/jet/v2/qrm/pgxqrm")
+}
@@ -0,0 +1,163 @@
+package jet
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
+)
+
+type namedParameter struct {
+	ExpressionInterfaceImpl
+
+	name      string
+	valueKind paramValueKind // if not empty, parameter value has to be of valueKind
+}
+
+func newNamedParameter(name string, valueKind paramValueKind) Expression {
+	if name == "" {
+		panic("jet: named parameter name can not be empty")
+	}
+
+	param := &namedParameter{
+		name:      name,
+		valueKind: valueKind,
+	}
+	param.ExpressionInterfaceImpl.Parent = param
+
+	return param
+}
+
+func (p *namedParameter) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.insertNamedParameter(p.name, p.valueKind)
+}
+
+// Param creates new named parameter expression of the type T, which value has to be set at statement execution time,
+// using statement WithArgs method or prepared statement named arguments. T has to be one of the jet expression
+// interfaces, and parameter can be used wherever expression of the type T is expected, for instance:
+//
+//	SELECT(Film.Title).FROM(Film).LIMIT_e(Param[IntegerExpression]("limit"))
+//
+// Parameter value is checked against the expression type, for instance integer expression parameter accepts only
+// integer values. Values implementing driver.Valuer interface and values with registered type converter are always accepted.
+func Param[T Expression](name string) T {
+	return TypedExp[T](newNamedParameter(name, getParamValueKind[T]()))
+}
+
+// BoolParam creates new named parameter bool expression
+func BoolParam(name string) BoolExpression {
+	return Param[BoolExpression](name)
+}
+
+// IntParam creates new named parameter integer expression
+func IntParam(name string) IntegerExpression {
+	return Param[IntegerExpression](name)
+}
+
+// FloatParam creates new named parameter float expression
+func FloatParam(name string) FloatExpression {
+	return Param[FloatExpression](name)
+}
+
+// StringParam creates new named parameter string expression
+func StringParam(name string) StringExpression {
+	return Param[StringExpression](name)
+}
+
+// DateParam creates new named parameter date expression
+func DateParam(name string) DateExpression {
+	return Param[DateExpression](name)
+}
+
+// TimeParam creates new named parameter time expression
+func TimeParam(name string) TimeExpression {
+	return Param[TimeExpression](name)
+}
+
+// TimezParam creates new named parameter time with time zone expression
+func TimezParam(name string) TimezExpression {
+	return Param[TimezExpression](name)
+}
+
+// TimestampParam creates new named parameter timestamp expression
+func TimestampParam(name string) TimestampExpression {
+	return Param[TimestampExpression](name)
+}
+
+// TimestampzParam creates new named parameter timestamp with time zone expression
+func TimestampzParam(name string) TimestampzExpression {
+	return Param[TimestampzExpression](name)
+}
+
+// paramValueKind is a kind of the values accepted by named parameter
+type paramValueKind string
+
+const (
+	anyParamValue      paramValueKind = ""
+	boolParamValue     paramValueKind = "bool"
+	integerParamValue  paramValueKind = "integer"
+	floatParamValue    paramValueKind = "float"
+	stringParamValue   paramValueKind = "string"
+	dateTimeParamValue paramValueKind = "date/time"
+)
+
+func getParamValueKind[T Expression]() paramValueKind {
+	switch any((*T)(nil)).(type) {
+	case *BoolExpression:
+		return boolParamValue
+	case *IntegerExpression, *Int4Expression, *Int8Expression:
+		return integerParamValue
+	case *FloatExpression, *DecimalExpression, *NumericExpression:
+		return floatParamValue
+	case *StringExpression:
+		return stringParamValue
+	case *DateExpression, *TimeExpression, *TimezExpression, *TimestampExpression, *TimestampzExpression:
+		return dateTimeParamValue
+	}
+
+	return anyParamValue
+}
+
+func checkNamedParameterValue(name string, valueKind paramValueKind, value interface{}) error {
+	if valueKind == anyParamValue || value == nil || isParamValueOfKind(value, valueKind) {
+		return nil
+	}
+
+	return fmt.Errorf("jet: named parameter '%s' value has to be of %s type, got %T", name, valueKind, value)
+}
+
+func isParamValueOfKind(value interface{}, valueKind paramValueKind) bool {
+	if _, ok := value.(driver.Valuer); ok {
+		return true
+	}
+
+	if _, ok := typeconv.Lookup(reflect.TypeOf(value)); ok {
+		return true
+	}
+
+	if _, ok := value.(time.Time); ok {
+		return valueKind == dateTimeParamValue
+	}
+
+	kind := reflect.Indirect(reflect.ValueOf(value)).Kind()
+
+	switch kind {
+	case reflect.Bool:
+		return valueKind == boolParamValue
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return valueKind == integerParamValue || valueKind == floatParamValue
+	case reflect.Float32, reflect.Float64:
+		return valueKind == floatParamValue
+	case reflect.String:
+		// float parameter accepts decimal numbers as strings, and date/time parameters accept date/time strings
+		return valueKind == stringParamValue || valueKind == floatParamValue || valueKind == dateTimeParamValue
+	case reflect.Slice:
+		_, isBytes := value.([]byte)
+		return isBytes && valueKind == stringParamValue
+	}
+
+	return false
+}
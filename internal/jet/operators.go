@@ -154,7 +154,8 @@ func (c *caseOperatorImpl) ELSE(els Expression) CaseOperator {
 }
 
 func (c *caseOperatorImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString("(CASE")
+	out.WriteString("(")
+	out.WriteKeyword("CASE")
 
 	if c.expression != nil {
 		c.expression.serialize(statement, out, FallTrough(options)...)
@@ -169,19 +170,20 @@ func (c *caseOperatorImpl) serialize(statement StatementType, out *SQLBuilder, o
 	}
 
 	for i, when := range c.when {
-		out.WriteString("WHEN")
+		out.WriteKeyword("WHEN")
 		when.serialize(statement, out, NoWrap)
 
-		out.WriteString("THEN")
+		out.WriteKeyword("THEN")
 		c.then[i].serialize(statement, out, NoWrap)
 	}
 
 	if c.els != nil {
-		out.WriteString("ELSE")
+		out.WriteKeyword("ELSE")
 		c.els.serialize(statement, out, NoWrap)
 	}
 
-	out.WriteString("END)")
+	out.WriteKeyword("END")
+	out.WriteString(")")
 }
 
 // DISTINCT operator can be used to return distinct values of expr
@@ -0,0 +1,81 @@
+package jet
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// ErrStaleObject is returned by Exec of the UPDATE statement of the versioned table, if no rows are updated, because
+// the model version does not match the row version (the row has been modified since the model was read),
+// or the row does not exist.
+var ErrStaleObject = errors.New("jet: stale object, row version does not match model version")
+
+// UnwindVersionedRowFromModel returns UPDATE statement SET clause columns and values from the model. If the table is
+// versioned table, version column is added to the columns (if not already listed) with the value incremented,
+// and the returned version check condition matches the row version with the model version.
+// UnwindVersionedRowFromModel panics if the model version is nil.
+func UnwindVersionedRowFromModel(table Table, columns []Column, data interface{}) ([]Column, []Serializer, BoolExpression) {
+	versionColumn := table.versionColumn()
+
+	if versionColumn == nil {
+		return columns, UnwindRowFromModel(columns, data), nil
+	}
+
+	versionIndex := -1
+
+	for i, column := range columns {
+		if column != nil && column.Name() == versionColumn.Name() {
+			versionIndex = i
+		}
+	}
+
+	if versionIndex < 0 {
+		columns = append(columns[:len(columns):len(columns)], versionColumn)
+		versionIndex = len(columns) - 1
+	}
+
+	row := UnwindRowFromModel(columns, data)
+	modelVersion := row[versionIndex].(*literalExpressionImpl)
+
+	if modelVersion.value == nil {
+		// version = NULL condition never matches, so the update would always fail as stale object
+		panic("jet: model version is nil, version column '" + versionColumn.Name() + "' has to be set")
+	}
+
+	row[versionIndex] = NewBinaryOperatorExpression(versionColumn, Int(1), "+")
+
+	return columns, row, Eq(versionColumn, modelVersion)
+}
+
+// checkStaleQuery returns ErrStaleObject if the query with the version check did not return any rows
+func checkStaleQuery(execution *Execution, err error, versionChecked bool) error {
+	if !versionChecked {
+		return err
+	}
+
+	if errors.Is(err, qrm.ErrNoRows) || (err == nil && execution.RowsProcessed == 0) {
+		return ErrStaleObject
+	}
+
+	return err
+}
+
+// checkStaleObject returns ErrStaleObject if the statement with the version check did not affect any rows
+func checkStaleObject(res sql.Result, err error, versionChecked bool) (sql.Result, error) {
+	if err != nil || !versionChecked {
+		return res, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+
+	if err != nil {
+		return res, err
+	}
+
+	if rowsAffected == 0 {
+		return res, ErrStaleObject
+	}
+
+	return res, nil
+}
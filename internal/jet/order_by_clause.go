@@ -55,17 +55,17 @@ func (ord *orderByClauseImpl) serializeForOrderBy(statement StatementType, out *
 
 	if ord.ascending != nil {
 		if *ord.ascending {
-			out.WriteString("ASC")
+			out.WriteKeyword("ASC")
 		} else {
-			out.WriteString("DESC")
+			out.WriteKeyword("DESC")
 		}
 	}
 
 	if ord.nullsFirst != nil {
 		if *ord.nullsFirst {
-			out.WriteString("NULLS FIRST")
+			out.WriteKeyword("NULLS FIRST")
 		} else {
-			out.WriteString("NULLS LAST")
+			out.WriteKeyword("NULLS LAST")
 		}
 	}
 }
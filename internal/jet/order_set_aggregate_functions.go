@@ -53,8 +53,8 @@ type orderSetAggregateFuncExpression struct {
 }
 
 func (p *orderSetAggregateFuncExpression) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString(p.name)
+	out.WriteKeyword(p.name)
 	WRAP(p.fraction).serialize(statement, out, FallTrough(options)...)
-	out.WriteString("WITHIN GROUP")
+	out.WriteKeyword("WITHIN GROUP")
 	p.orderBy.serialize(statement, out)
 }
@@ -0,0 +1,201 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/utils/must"
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
+	"github.com/go-jet/jet/v2/qrm"
+)
+
+// PreparedStatement is a statement prepared over database connection or transaction. PreparedStatement can be
+// executed multiple times, each time with the new values of the named arguments. Arguments not listed in the
+// execution named arguments are bound to the values from the statement construction.
+// PreparedStatement is safe for concurrent use by multiple goroutines. It should be closed when no longer needed.
+type PreparedStatement struct {
+	stmt         *sql.Stmt
+	statement    *serializerStatementInterfaceImpl
+	interceptors []Interceptor
+
+	query         string
+	args          []interface{}
+	namedArgs     map[string][]int // positions of the named arguments in args
+	paramKinds    map[string]paramValueKind
+	unboundParams []string // named parameters which values have to be set at execution time
+
+	versionChecked bool
+}
+
+func (s *serializerStatementInterfaceImpl) Prepare(ctx context.Context, db qrm.Preparable) (*PreparedStatement, error) {
+	must.BeInitializedPtr(db, "jet: db is nil")
+
+	s = s.withContext(ctx)
+	sqlBuilder := &SQLBuilder{Dialect: s.dialect, allowUnboundParams: true}
+
+	query, args, err := s.build(sqlBuilder)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stmt, err := db.PrepareContext(ctx, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &PreparedStatement{
+		stmt:          stmt,
+		statement:     s,
+		interceptors:  dbInterceptors(db),
+		query:         query,
+		args:          args,
+		namedArgs:     sqlBuilder.namedArgs,
+		paramKinds:    sqlBuilder.namedParamKinds,
+		unboundParams: sqlBuilder.unboundParams,
+
+		versionChecked: sqlBuilder.versionChecked,
+	}, nil
+}
+
+// Query executes prepared statement with named arguments and stores row results in destination.
+// Destination can be either pointer to struct or pointer to a slice.
+// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
+// Method returns an error if a named parameter value is not set, or if named arguments contain unknown name.
+func (p *PreparedStatement) Query(ctx context.Context, destination interface{}, namedArgs ...map[string]interface{}) error {
+	execution, err := p.newExecution(QueryExecution, destination, namedArgs)
+
+	if err != nil {
+		return err
+	}
+
+	err = queryContext(ctx, preparedStmt{p.stmt}, p.interceptors, p.statement.statementType, execution)
+
+	return checkStaleQuery(execution, err, p.versionChecked)
+}
+
+// Exec executes prepared statement with named arguments without returning any rows.
+// Method returns an error if a named parameter value is not set, or if named arguments contain unknown name.
+func (p *PreparedStatement) Exec(ctx context.Context, namedArgs ...map[string]interface{}) (sql.Result, error) {
+	execution, err := p.newExecution(ExecExecution, nil, namedArgs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := execContext(ctx, preparedStmt{p.stmt}, p.interceptors, execution)
+
+	return checkStaleObject(res, err, p.versionChecked)
+}
+
+// Rows executes prepared statement with named arguments and returns rows.
+// Method returns an error if a named parameter value is not set, or if named arguments contain unknown name.
+func (p *PreparedStatement) Rows(ctx context.Context, namedArgs ...map[string]interface{}) (*Rows, error) {
+	execution, err := p.newExecution(RowsExecution, nil, namedArgs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rowsContext(ctx, preparedStmt{p.stmt}, p.interceptors, execution)
+}
+
+// Close closes the prepared statement
+func (p *PreparedStatement) Close() error {
+	return p.stmt.Close()
+}
+
+func (p *PreparedStatement) newExecution(executionType ExecutionType, destination interface{}, namedArgs []map[string]interface{}) (*Execution, error) {
+	values := mergeNamedArgs(namedArgs)
+
+	for _, name := range p.unboundParams {
+		if _, ok := values[name]; !ok {
+			return nil, fmt.Errorf("jet: named parameter '%s' value is not set", name)
+		}
+	}
+
+	args := make([]interface{}, len(p.args))
+	copy(args, p.args)
+
+	for name, value := range values {
+		positions, ok := p.namedArgs[name]
+
+		if !ok {
+			return nil, fmt.Errorf("jet: named argument '%s' does not appear in prepared statement", name)
+		}
+
+		if err := checkNamedParameterValue(name, p.paramKinds[name], value); err != nil {
+			return nil, err
+		}
+
+		driverValue, err := typeconv.ToDriverValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("jet: failed to convert %T argument to driver value: %w", value, err)
+		}
+
+		for _, position := range positions {
+			args[position] = driverValue
+		}
+	}
+
+	return &Execution{
+		Type: executionType,
+		Statement: boundStatement{
+			statement: p.statement,
+			query:     p.query,
+			args:      args,
+			namedArgs: mergeNamedArgs([]map[string]interface{}{p.statement.namedArgValues, values}),
+		},
+		Query:       p.query,
+		Args:        args,
+		Destination: destination,
+	}, nil
+}
+
+func mergeNamedArgs(namedArgs []map[string]interface{}) map[string]interface{} {
+	ret := make(map[string]interface{})
+
+	for _, args := range namedArgs {
+		for name, value := range args {
+			ret[name] = value
+		}
+	}
+
+	return ret
+}
+
+// boundStatement is a printable statement with the named arguments bound to the new values
+type boundStatement struct {
+	statement *serializerStatementInterfaceImpl
+	query     string
+	args      []interface{}
+	namedArgs map[string]interface{}
+}
+
+func (b boundStatement) Sql() (query string, args []interface{}) {
+	return b.query, b.args
+}
+
+func (b boundStatement) DebugSql() (query string) {
+	statement := b.statement.copy()
+	statement.namedArgValues = b.namedArgs
+
+	query, _, _ = statement.build(&SQLBuilder{Dialect: statement.dialect, Debug: true})
+	return
+}
+
+// preparedStmt adapts sql.Stmt to qrm.Queryable and qrm.Executable interfaces. Query text is ignored,
+// because sql.Stmt is already prepared.
+type preparedStmt struct {
+	*sql.Stmt
+}
+
+func (p preparedStmt) QueryContext(ctx context.Context, _ string, args ...interface{}) (*sql.Rows, error) {
+	return p.Stmt.QueryContext(ctx, args...)
+}
+
+func (p preparedStmt) ExecContext(ctx context.Context, _ string, args ...interface{}) (sql.Result, error) {
+	return p.Stmt.ExecContext(ctx, args...)
+}
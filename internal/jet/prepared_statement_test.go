@@ -0,0 +1,245 @@
+package jet
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver is a database/sql driver recording prepared queries and statement arguments
+type fakeDriver struct{}
+
+var fakeConns = map[string]*fakeConn{}
+
+func init() {
+	sql.Register("jet_fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConns[name], nil
+}
+
+type fakeConn struct {
+	prepared []string
+	executed [][]driver.Value
+	columns  []string
+	rows     [][]driver.Value
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	c.prepared = append(c.prepared, query)
+	return &fakeStmt{conn: c}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	conn *fakeConn
+}
+
+func (s *fakeStmt) Close() error { return nil }
+
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.conn.executed = append(s.conn.executed, args)
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	s.conn.executed = append(s.conn.executed, args)
+	return &fakeRows{columns: s.conn.columns, rows: s.conn.rows}, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+
+	return nil
+}
+
+func openFakeDB(t *testing.T, conn *fakeConn) *sql.DB {
+	fakeConns[t.Name()] = conn
+
+	db, err := sql.Open("jet_fake", t.Name())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = db.Close()
+		delete(fakeConns, t.Name())
+	})
+
+	return db
+}
+
+func newSelectUserStatement() ConfigurableStatement {
+	return RawStatement(defaultDialect,
+		"SELECT id AS \"user.id\", name AS \"user.name\" FROM users WHERE id = #id OR name = #name OR parent_id = #id",
+		map[string]interface{}{"#id": 1, "#name": "John"},
+	)
+}
+
+func TestPreparedStatementQuery(t *testing.T) {
+	conn := &fakeConn{
+		columns: []string{"user.id", "user.name"},
+		rows:    [][]driver.Value{{int64(2), "Mike"}},
+	}
+	db := openFakeDB(t, conn)
+
+	var debugSql []string
+
+	ctx := ContextWithInterceptors(context.Background(), QueryLoggerInterceptor(func(ctx context.Context, info QueryInfo) {
+		debugSql = append(debugSql, info.Statement.DebugSql())
+	}))
+
+	stmt, err := newSelectUserStatement().Prepare(ctx, db)
+	require.NoError(t, err)
+	defer stmt.Close()
+
+	type User struct {
+		ID   int64
+		Name string
+	}
+
+	var user User
+	err = stmt.Query(ctx, &user, map[string]interface{}{"#id": 2})
+	require.NoError(t, err)
+	require.Equal(t, User{ID: 2, Name: "Mike"}, user)
+
+	_, err = stmt.Exec(ctx, map[string]interface{}{"#name": "Paul"}, map[string]interface{}{"#id": 3})
+	require.NoError(t, err)
+
+	_, err = stmt.Exec(ctx)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{
+		"SELECT id AS \"user.id\", name AS \"user.name\" FROM users WHERE id = $1 OR name = $2 OR parent_id = $1;\n",
+	}, conn.prepared)
+
+	require.Equal(t, [][]driver.Value{
+		{int64(2), "John"},
+		{int64(3), "Paul"},
+		{int64(1), "John"},
+	}, conn.executed)
+
+	require.Equal(t, []string{
+		"SELECT id AS \"user.id\", name AS \"user.name\" FROM users WHERE id = 2 OR name = 'John' OR parent_id = 2;\n",
+		"SELECT id AS \"user.id\", name AS \"user.name\" FROM users WHERE id = 3 OR name = 'Paul' OR parent_id = 3;\n",
+		"SELECT id AS \"user.id\", name AS \"user.name\" FROM users WHERE id = 1 OR name = 'John' OR parent_id = 1;\n",
+	}, debugSql)
+}
+
+func TestPreparedStatementRows(t *testing.T) {
+	conn := &fakeConn{
+		columns: []string{"user.id", "user.name"},
+		rows:    [][]driver.Value{{int64(1), "John"}, {int64(2), "Mike"}},
+	}
+
+	stmt, err := newSelectUserStatement().Prepare(context.Background(), openFakeDB(t, conn))
+	require.NoError(t, err)
+	defer stmt.Close()
+
+	rows, err := stmt.Rows(context.Background(), map[string]interface{}{"#name": "Mike"})
+	require.NoError(t, err)
+	defer rows.Close()
+
+	type User struct {
+		Name string
+	}
+
+	var names []string
+
+	for rows.Next() {
+		var user User
+		require.NoError(t, rows.Scan(&user))
+		names = append(names, user.Name)
+	}
+
+	require.Equal(t, []string{"John", "Mike"}, names)
+	require.Equal(t, [][]driver.Value{{int64(1), "Mike"}}, conn.executed)
+}
+
+func TestPreparedStatementUnknownNamedArgument(t *testing.T) {
+	stmt, err := newSelectUserStatement().Prepare(context.Background(), openFakeDB(t, &fakeConn{}))
+	require.NoError(t, err)
+	defer stmt.Close()
+
+	_, err = stmt.Exec(context.Background(), map[string]interface{}{"#age": 30})
+	require.EqualError(t, err, "jet: named argument '#age' does not appear in prepared statement")
+
+	var dest []struct{ ID int }
+	err = stmt.Query(context.Background(), &dest, map[string]interface{}{"#age": 30})
+	require.EqualError(t, err, "jet: named argument '#age' does not appear in prepared statement")
+
+	rows, err := stmt.Rows(context.Background(), map[string]interface{}{"#age": 30})
+	require.Nil(t, rows)
+	require.EqualError(t, err, "jet: named argument '#age' does not appear in prepared statement")
+}
+
+func TestPreparedStatementInterceptedDB(t *testing.T) {
+	var executions []ExecutionType
+
+	db := WithInterceptors(openFakeDB(t, &fakeConn{}), func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+		executions = append(executions, execution.Type)
+		return next(ctx, execution)
+	})
+
+	stmt, err := newSelectUserStatement().Prepare(context.Background(), db)
+	require.NoError(t, err)
+	defer stmt.Close()
+
+	_, err = stmt.Exec(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []ExecutionType{ExecExecution}, executions)
+}
+
+func TestPreparedStatementNamedParameters(t *testing.T) {
+	conn := &fakeConn{}
+
+	selectStmt := &statementImpl{
+		Clauses: []Clause{
+			&ClauseSelect{ProjectionList: []Projection{IntParam("id"), Param[StringExpression]("name")}},
+		},
+	}
+	selectStmt.serializerStatementInterfaceImpl = serializerStatementInterfaceImpl{
+		dialect:       defaultDialect,
+		statementType: SelectStatementType,
+		parent:        selectStmt,
+	}
+
+	stmt, err := selectStmt.WithArgs(map[string]interface{}{"name": "John"}).Prepare(context.Background(), openFakeDB(t, conn))
+	require.NoError(t, err)
+	defer stmt.Close()
+
+	_, err = stmt.Exec(context.Background())
+	require.EqualError(t, err, "jet: named parameter 'id' value is not set")
+
+	_, err = stmt.Exec(context.Background(), map[string]interface{}{"id": 1, "name": 2})
+	require.EqualError(t, err, "jet: named parameter 'name' value has to be of string type, got int")
+
+	_, err = stmt.Exec(context.Background(), map[string]interface{}{"id": 11})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"\nSELECT $1,\n     $2;\n"}, conn.prepared)
+	require.Equal(t, [][]driver.Value{{int64(11), "John"}}, conn.executed)
+}
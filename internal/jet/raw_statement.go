@@ -8,7 +8,7 @@ type rawStatementImpl struct {
 }
 
 // RawStatement creates new sql statements from raw query and optional map of named arguments
-func RawStatement(dialect Dialect, rawQuery string, namedArgument ...map[string]interface{}) Statement {
+func RawStatement(dialect Dialect, rawQuery string, namedArgument ...map[string]interface{}) ConfigurableStatement {
 	newRawStatement := rawStatementImpl{
 		serializerStatementInterfaceImpl: serializerStatementInterfaceImpl{
 			dialect:       dialect,
@@ -32,6 +32,8 @@ func (s *rawStatementImpl) projections() ProjectionList {
 }
 
 func (s *rawStatementImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.visit(Node{Kind: StatementNode, StatementType: s.statementType})
+
 	if !contains(options, NoWrap) {
 		out.WriteString("(")
 		out.IncreaseIdent()
@@ -42,10 +42,10 @@ func (s *selectLockImpl) SKIP_LOCKED() RowLock {
 }
 
 func (s *selectLockImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString(s.lockStrength)
+	out.WriteKeyword(s.lockStrength)
 
 	if len(s.of) > 0 {
-		out.WriteString("OF")
+		out.WriteKeyword("OF")
 
 		for i, of := range s.of {
 			if i > 0 {
@@ -62,10 +62,10 @@ func (s *selectLockImpl) serialize(statement StatementType, out *SQLBuilder, opt
 	}
 
 	if s.noWait {
-		out.WriteString("NOWAIT")
+		out.WriteKeyword("NOWAIT")
 	}
 
 	if s.skipLocked {
-		out.WriteString("SKIP LOCKED")
+		out.WriteKeyword("SKIP LOCKED")
 	}
 }
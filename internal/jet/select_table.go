@@ -38,7 +38,7 @@ func (s selectTableImpl) AllColumns() ProjectionList {
 func (s selectTableImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	s.Statement.serialize(statement, out)
 
-	out.WriteString("AS")
+	out.WriteKeyword("AS")
 	out.WriteIdentifier(s.alias)
 }
 
@@ -54,9 +54,9 @@ func NewLateral(selectStmt SerializerStatement, alias string) SelectTable {
 }
 
 func (s lateralImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
-	out.WriteString("LATERAL")
+	out.WriteKeyword("LATERAL")
 	s.Statement.serialize(statement, out)
 
-	out.WriteString("AS")
+	out.WriteKeyword("AS")
 	out.WriteIdentifier(s.alias)
 }
@@ -32,6 +32,11 @@ const (
 	LockStatementType   StatementType = "LOCK"
 	UnLockStatementType StatementType = "UNLOCK"
 	WithStatementType   StatementType = "WITH"
+	MergeStatementType  StatementType = "MERGE"
+	CallStatementType   StatementType = "CALL"
+
+	SelectJsonObjStatementType StatementType = "SELECT_JSON_OBJ"
+	SelectJsonArrStatementType StatementType = "SELECT_JSON_ARR"
 )
 
 // Serializer interface
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/3rdparty/pq"
 	"github.com/go-jet/jet/v2/internal/utils/is"
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
 	"github.com/google/uuid"
 	"reflect"
 	"sort"
@@ -25,6 +26,38 @@ type SQLBuilder struct {
 	ident    int
 
 	Debug bool
+
+	format      Format
+	pendingLine bool // set if new line is written in compact format
+	lineComment bool // set if the current output line contains line comment
+
+	// positions of the named arguments in Args
+	namedArgs map[string][]int
+	// values of the named parameters, overriding named argument values as well
+	namedArgValues map[string]interface{}
+	// if set, named parameters without value are serialized as NULL arguments, instead of panic
+	allowUnboundParams bool
+	unboundParams      []string
+	namedParamKinds    map[string]paramValueKind
+
+	// first error found while building the statement, for instance argument type converter error or table filter
+	// that can not be applied to the statement
+	err error
+
+	// if set, visitor is called for the nodes of the serialized statement tree
+	visitor Visitor
+	depth   int
+
+	// table filters applied to the serialized statement
+	tableFilters      []*TableFilter
+	tableFilterScopes []*tableFilterScope
+	columnQualifiers  map[string]string // table name to table alias, for the columns of the filter predicates
+	// if set, all the columns are qualified with, for instance INSERTED columns of the OUTPUT clause
+	allColumnsQualifier string
+	// if set, soft delete tables behave as regular tables
+	unscoped bool
+	// set if the statement contains optimistic lock version check
+	versionChecked bool
 }
 
 const tabSize = 4
@@ -63,8 +96,13 @@ func (s *SQLBuilder) WriteProjections(statement StatementType, projections []Pro
 
 // NewLine adds new line to output SQL
 func (s *SQLBuilder) NewLine() {
+	if s.format.Compact {
+		s.pendingLine = true
+		return
+	}
+
 	s.write([]byte{'\n'})
-	s.write(bytes.Repeat([]byte{' '}, s.ident))
+	s.write(bytes.Repeat([]byte{' '}, s.format.indentSpaces(s.ident)))
 }
 
 func (s *SQLBuilder) write(data []byte) {
@@ -72,12 +110,34 @@ func (s *SQLBuilder) write(data []byte) {
 		return
 	}
 
+	if s.pendingLine { // in compact format, new line is replaced with space, unless it is not needed
+		s.pendingLine = false
+
+		if s.lineComment { // line comment has to be terminated with new line, otherwise it would comment out the rest of the query
+			s.Buff.WriteByte('\n')
+			s.lastChar = '\n'
+			s.lineComment = false
+		} else if s.lastChar != '(' && s.lastChar != ' ' && !isPostSeparator(data[0]) && s.Buff.Len() > 0 {
+			s.Buff.WriteByte(' ')
+			s.lastChar = ' '
+		}
+	}
+
 	if !isPreSeparator(s.lastChar) && !isPostSeparator(data[0]) && s.Buff.Len() > 0 {
 		s.Buff.WriteByte(' ')
 	}
 
 	s.Buff.Write(data)
 	s.lastChar = data[len(data)-1]
+
+	if i := bytes.LastIndexByte(data, '\n'); i >= 0 {
+		s.lineComment = false
+		data = data[i+1:]
+	}
+
+	if bytes.Contains(data, []byte("--")) {
+		s.lineComment = true
+	}
 }
 
 func isPreSeparator(b byte) bool {
@@ -91,22 +151,46 @@ func isPostSeparator(b byte) bool {
 // WriteAlias is used to add alias to output SQL
 func (s *SQLBuilder) WriteAlias(str string) {
 	aliasQuoteChar := string(s.Dialect.AliasQuoteChar())
-	s.WriteString(aliasQuoteChar + str + aliasQuoteChar)
+	s.write([]byte(aliasQuoteChar + str + aliasQuoteChar))
 }
 
-// WriteString writes sting to output SQL
+// WriteString writes string to output SQL verbatim. Use WriteKeyword for keywords, operators and function names.
 func (s *SQLBuilder) WriteString(str string) {
 	s.write([]byte(str))
 }
 
+// WriteKeyword writes keyword, operator or function name emitted by the serializer, in the format keyword case
+func (s *SQLBuilder) WriteKeyword(keyword string) {
+	s.write([]byte(s.format.keyword(keyword)))
+}
+
+// WriteFunctionCall adds function name, formatted as keyword, followed by the opening bracket of the function arguments
+func (s *SQLBuilder) WriteFunctionCall(name string) {
+	if name == "" { // for instance SQLite ROW constructor
+		s.WriteString("(")
+		return
+	}
+
+	s.WriteKeyword(name)
+	s.Buff.WriteByte('(')
+	s.lastChar = '('
+}
+
 // WriteIdentifier adds identifier to output SQL
 func (s *SQLBuilder) WriteIdentifier(name string, alwaysQuote ...bool) {
+	s.write([]byte(s.identifier(name, alwaysQuote...)))
+}
+
+func (s *SQLBuilder) identifier(name string, alwaysQuote ...bool) string {
 	if s.shouldQuote(name, alwaysQuote...) {
 		identQuoteChar := string(s.Dialect.IdentifierQuoteChar())
-		s.WriteString(identQuoteChar + name + identQuoteChar)
-	} else {
-		s.WriteString(name)
+		identCloseQuoteChar := string(s.Dialect.IdentifierCloseQuoteChar())
+		// close quote character inside identifier is escaped by doubling it, for instance ] becomes ]]
+		escapedName := strings.ReplaceAll(name, identCloseQuoteChar, identCloseQuoteChar+identCloseQuoteChar)
+		return identQuoteChar + escapedName + identCloseQuoteChar
 	}
+
+	return name
 }
 
 func (s *SQLBuilder) shouldQuote(name string, alwaysQuote ...bool) bool {
@@ -119,10 +203,83 @@ func (s *SQLBuilder) WriteByte(b byte) {
 }
 
 func (s *SQLBuilder) finalize() (string, []interface{}) {
+	if s.lineComment {
+		s.Buff.WriteByte('\n')
+	}
+
+	if s.format.Compact {
+		return s.Buff.String() + ";", s.Args
+	}
+
 	return s.Buff.String() + ";\n", s.Args
 }
 
+func (s *SQLBuilder) namedArgValue(name string, value interface{}) interface{} {
+	if overrideValue, ok := s.namedArgValues[name]; ok {
+		return overrideValue
+	}
+
+	return value
+}
+
+func (s *SQLBuilder) insertNamedParameter(name string, valueKind paramValueKind) {
+	value, ok := s.namedArgValues[name]
+
+	if ok {
+		if err := checkNamedParameterValue(name, valueKind, value); err != nil {
+			panic(err.Error())
+		}
+	} else {
+		if !s.allowUnboundParams {
+			panic(fmt.Sprintf("jet: named parameter '%s' value is not set", name))
+		}
+
+		if _, exists := s.namedArgs[name]; !exists {
+			s.unboundParams = append(s.unboundParams, name)
+		}
+	}
+
+	if valueKind != anyParamValue {
+		if s.namedParamKinds == nil {
+			s.namedParamKinds = make(map[string]paramValueKind)
+		}
+		s.namedParamKinds[name] = valueKind
+	}
+
+	s.Args = append(s.Args, s.toDriverValue(value))
+	s.addNamedArgPosition(name)
+
+	if s.Debug {
+		s.insertConstantArgument(value)
+		return
+	}
+
+	s.WriteString(s.Dialect.ArgumentPlaceholder()(len(s.Args)))
+}
+
+// checkNamedArgValues panics if some of the named argument values does not appear in serialized statement
+func (s *SQLBuilder) checkNamedArgValues() {
+	for name := range s.namedArgValues {
+		if _, ok := s.namedArgs[name]; !ok {
+			panic(fmt.Sprintf("jet: named argument '%s' does not appear in statement", name))
+		}
+	}
+}
+
+func (s *SQLBuilder) addNamedArgPosition(name string) {
+	if s.namedArgs == nil {
+		s.namedArgs = make(map[string][]int)
+	}
+
+	s.namedArgs[name] = append(s.namedArgs[name], len(s.Args)-1)
+}
+
 func (s *SQLBuilder) insertConstantArgument(arg interface{}) {
+	if _, isBool := arg.(bool); isBool || is.Nil(arg) {
+		s.WriteKeyword(argToString(arg)) // TRUE, FALSE or NULL
+		return
+	}
+
 	s.WriteString(argToString(arg))
 }
 
@@ -132,7 +289,7 @@ func (s *SQLBuilder) insertParametrizedArgument(arg interface{}) {
 		return
 	}
 
-	s.Args = append(s.Args, arg)
+	s.Args = append(s.Args, s.toDriverValue(arg))
 	argPlaceholder := s.Dialect.ArgumentPlaceholder()(len(s.Args))
 
 	s.WriteString(argPlaceholder)
@@ -185,7 +342,10 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 		if !strings.Contains(raw, namedArgumentPos.Name) {
 			continue
 		}
-		s.Args = append(s.Args, namedArgumentPos.Value)
+		value := s.namedArgValue(namedArgumentPos.Name, namedArgumentPos.Value)
+
+		s.Args = append(s.Args, s.toDriverValue(value))
+		s.addNamedArgPosition(namedArgumentPos.Name)
 		currentArgNum := len(s.Args)
 
 		placeholder := s.Dialect.ArgumentPlaceholder()(currentArgNum)
@@ -196,7 +356,7 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 		}
 
 		if s.Debug {
-			placeholder = argToString(namedArgumentPos.Value)
+			placeholder = argToString(value)
 		}
 
 		raw = strings.Replace(raw, namedArgumentPos.Name, placeholder, toReplace)
@@ -205,11 +365,41 @@ func (s *SQLBuilder) insertRawQuery(raw string, namedArg map[string]interface{})
 	s.WriteString(raw)
 }
 
+// toDriverValue converts argument using registered type converter, if any. Converter error is recorded in the
+// builder, and the argument is returned unconverted.
+func (s *SQLBuilder) toDriverValue(arg interface{}) interface{} {
+	driverValue, err := typeconv.ToDriverValue(arg)
+
+	if err != nil {
+		s.setErr(fmt.Errorf("jet: failed to convert %T argument to driver value: %w", arg, err))
+		return arg
+	}
+
+	return driverValue
+}
+
+// setErr records the error found while building the statement. Only the first error is kept.
+func (s *SQLBuilder) setErr(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
 func argToString(value interface{}) string {
 	if is.Nil(value) {
 		return "NULL"
 	}
 
+	if converter, ok := typeconv.Lookup(reflect.TypeOf(value)); ok && converter.ToDriver != nil {
+		val, err := converter.ToDriver(value)
+
+		if err != nil {
+			return err.Error() // argToString is called only from DebugSQL
+		}
+
+		return argToString(val)
+	}
+
 	switch bindVal := value.(type) {
 	case bool:
 		if bindVal {
@@ -3,16 +3,25 @@ package jet
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/go-jet/jet/v2/qrm"
 	"time"
 )
 
 // Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK)
+// Statement builder methods modify the statement they are called on. Constructed statement can be serialized and executed
+// concurrently from multiple goroutines, as long as it is not modified afterwards. To derive new statements from the shared
+// base statement, use Clone method of the specific statement type first.
 type Statement interface {
 	// Sql returns parametrized sql query with list of arguments.
+	// Sql panics if the statement contains named parameter without value set, or if named parameter value is not
+	// of the parameter type. Use WithArgs to set named parameter values. Sql also panics if the table filter can not
+	// be applied to the statement, or if the registered type converter fails to convert an argument, while statement
+	// execution methods return an error instead.
 	Sql() (query string, args []interface{})
 	// DebugSql returns debug query where every parametrized placeholder is replaced with its argument string representation.
-	// Do not use it in production. Use it only for debug purposes.
+	// Do not use it in production. Use it only for debug purposes. DebugSql panics in the same cases as Sql.
 	DebugSql() (query string)
 	// Query executes statement over database connection/transaction db and stores row results in destination.
 	// Destination can be either pointer to struct or pointer to a slice.
@@ -25,11 +34,48 @@ type Statement interface {
 	// Exec executes statement over db connection/transaction without returning any rows.
 	Exec(db qrm.Executable) (sql.Result, error)
 	// ExecContext executes statement with context over db connection/transaction without returning any rows.
+	// If the statement contains optimistic lock version check and no rows are affected, method returns ErrStaleObject.
+	// Query and QueryContext return ErrStaleObject as well, if the statement with the version check returns no rows.
 	ExecContext(ctx context.Context, db qrm.Executable) (sql.Result, error)
 	// Rows executes statements over db connection/transaction and returns rows
 	Rows(ctx context.Context, db qrm.Queryable) (*Rows, error)
 }
 
+// ConfigurableStatement is implemented by all the statements constructed using jet statement builders. It extends Statement
+// with prepared statement creation, and with the methods returning new statement with the changed serialization or
+// execution options. Those methods can be chained, and the original statement is not modified.
+type ConfigurableStatement interface {
+	Statement
+	// Prepare creates prepared statement over db connection/transaction. Prepared statement can be executed
+	// multiple times with the new values of the named arguments.
+	Prepare(ctx context.Context, db qrm.Preparable) (*PreparedStatement, error)
+	// WithArgs returns new statement with the values of named parameters and named raw arguments set.
+	// Original statement is not modified, so the same statement can be executed concurrently with different values.
+	// All the named parameter values have to be set before the statement is serialized or executed, otherwise
+	// statement serialization panics. Prepared statements return an error instead.
+	WithArgs(namedArgs map[string]interface{}) ConfigurableStatement
+	// WithTableFilters returns new statement with the table filters applied. Original statement is not modified.
+	WithTableFilters(filters ...*TableFilter) ConfigurableStatement
+	// Unscoped returns new statement with the soft delete behaviour of the soft delete tables disabled. Soft deleted
+	// rows are not excluded from the result, and DELETE statement deletes the rows. Original statement is not modified.
+	Unscoped() ConfigurableStatement
+	// WithFormat returns new statement serialized with the format, instead of the dialect format.
+	// Original statement is not modified.
+	WithFormat(format Format) ConfigurableStatement
+	// WithDialect returns new statement serialized with the dialect, instead of the dialect of the statement package.
+	// It allows custom dialects to reuse the statement builders of the existing dialect packages.
+	// Original statement is not modified.
+	WithDialect(dialect Dialect) ConfigurableStatement
+
+	walkableStatement
+	contextStatement
+}
+
+// contextStatement is implemented by all the jet statements
+type contextStatement interface {
+	withContext(ctx context.Context) *serializerStatementInterfaceImpl
+}
+
 // Rows wraps sql.Rows type with a support for query result mapping
 type Rows struct {
 	*sql.Rows
@@ -45,7 +91,7 @@ func (r *Rows) Scan(destination interface{}) error {
 // SerializerStatement interface
 type SerializerStatement interface {
 	Serializer
-	Statement
+	ConfigurableStatement
 	HasProjections
 }
 
@@ -65,51 +111,197 @@ type serializerStatementInterfaceImpl struct {
 	dialect       Dialect
 	statementType StatementType
 	parent        SerializerStatement
+
+	namedArgValues map[string]interface{} // values of the named parameters and named arguments, set with WithArgs
+	tableFilters   []*TableFilter
+	unscoped       bool
+	format         *Format
 }
 
 func (s *serializerStatementInterfaceImpl) Sql() (query string, args []interface{}) {
+	query, args, err := s.build(&SQLBuilder{Dialect: s.dialect})
 
-	queryData := &SQLBuilder{Dialect: s.dialect}
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return query, args
+}
 
-	s.parent.serialize(s.statementType, queryData, NoWrap)
+func (s *serializerStatementInterfaceImpl) DebugSql() (query string) {
+	query, _, err := s.build(&SQLBuilder{Dialect: s.dialect, Debug: true})
+
+	if err != nil {
+		panic(err.Error())
+	}
 
-	query, args = queryData.finalize()
 	return
 }
 
-func (s *serializerStatementInterfaceImpl) DebugSql() (query string) {
-	sqlBuilder := &SQLBuilder{Dialect: s.dialect, Debug: true}
+// build serializes the statement, and returns an error if argument can not be converted to driver value or table
+// filter can not be applied to the statement
+func (s *serializerStatementInterfaceImpl) build(sqlBuilder *SQLBuilder) (query string, args []interface{}, err error) {
+	sqlBuilder.namedArgValues = s.namedArgValues
+	sqlBuilder.tableFilters = s.tableFilters
+	sqlBuilder.unscoped = s.unscoped
+	sqlBuilder.format = s.sqlFormat()
 
 	s.parent.serialize(s.statementType, sqlBuilder, NoWrap)
 
-	query, _ = sqlBuilder.finalize()
-	return
+	sqlBuilder.checkNamedArgValues()
+
+	query, args = sqlBuilder.finalize()
+
+	return query, args, sqlBuilder.err
 }
 
-func (s *serializerStatementInterfaceImpl) Query(db qrm.Queryable, destination interface{}) error {
-	return s.QueryContext(context.Background(), db, destination)
+func (s *serializerStatementInterfaceImpl) WithArgs(namedArgs map[string]interface{}) ConfigurableStatement {
+	ret := s.copy()
+	ret.namedArgValues = mergeNamedArgs([]map[string]interface{}{s.namedArgValues, namedArgs})
+	return ret
 }
 
-func (s *serializerStatementInterfaceImpl) QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
-	query, args := s.Sql()
+func (s *serializerStatementInterfaceImpl) WithTableFilters(filters ...*TableFilter) ConfigurableStatement {
+	ret := s.copy()
+	ret.tableFilters = appendTableFilters(s.tableFilters, filters)
+	return ret
+}
 
-	callLogger(ctx, s)
+func (s *serializerStatementInterfaceImpl) Unscoped() ConfigurableStatement {
+	ret := s.copy()
+	ret.unscoped = true
+	return ret
+}
 
-	var rowsProcessed int64
-	var err error
+func (s *serializerStatementInterfaceImpl) WithFormat(format Format) ConfigurableStatement {
+	ret := s.copy()
+	ret.format = &format
+	return ret
+}
 
-	duration := duration(func() {
-		rowsProcessed, err = qrm.Query(ctx, db, query, args, destination)
-	})
+func (s *serializerStatementInterfaceImpl) WithDialect(dialect Dialect) ConfigurableStatement {
+	if dialect == nil {
+		panic("jet: dialect is nil")
+	}
 
-	callQueryLoggerFunc(ctx, QueryInfo{
+	ret := s.copy()
+	ret.dialect = dialect
+	return ret
+}
+
+// copy returns a shallow copy of the statement, sharing the statement clauses
+func (s *serializerStatementInterfaceImpl) copy() *serializerStatementInterfaceImpl {
+	ret := *s
+	return &ret
+}
+
+// sqlFormat returns statement format, or dialect format if statement format is not set
+func (s *serializerStatementInterfaceImpl) sqlFormat() Format {
+	if s.format != nil {
+		return *s.format
+	}
+
+	return s.dialect.Format()
+}
+
+// withContext returns statement with the table filters from the context applied
+func (s *serializerStatementInterfaceImpl) withContext(ctx context.Context) *serializerStatementInterfaceImpl {
+	filters := contextTableFilters(ctx)
+
+	if len(filters) == 0 {
+		return s
+	}
+
+	return s.WithTableFilters(filters...).(*serializerStatementInterfaceImpl)
+}
+
+// BuildContext returns parametrized sql query with list of arguments, with the table filters from the context applied,
+// the same way statement execution methods serialize the statement. BuildContext returns an error in the cases Sql panics.
+// It is used by the statement executors not based on database/sql, like pgxqrm package.
+func BuildContext(ctx context.Context, statement Statement) (query string, args []interface{}, err error) {
+	s, err := contextStatementImpl(ctx, statement)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return s.build(&SQLBuilder{Dialect: s.dialect})
+}
+
+// ExecuteContext builds the statement the same way as BuildContext, and executes it with handler through the chain of
+// context interceptors. Execution Type and Destination should be set by the caller, while Statement, Query and Args
+// are set by ExecuteContext. Statement and query loggers are called the same way as for the statement execution methods.
+// If the statement contains optimistic lock version check and handler does not process any rows, ExecuteContext returns
+// ErrStaleObject, except for RowsExecution.
+// It is used by the statement executors not based on database/sql, like pgxqrm package.
+func ExecuteContext(ctx context.Context, statement Statement, execution *Execution, handler ExecutionHandler) error {
+	s, err := contextStatementImpl(ctx, statement)
+
+	if err != nil {
+		return err
+	}
+
+	sqlBuilder := &SQLBuilder{Dialect: s.dialect}
+	execution.Statement = s
+	execution.Query, execution.Args, err = s.build(sqlBuilder)
+
+	if err != nil {
+		return err
+	}
+
+	CallLogger(ctx, s)
+
+	duration, err := intercept(ctx, nil, execution, handler)
+
+	CallQueryLogger(ctx, QueryInfo{
 		Statement:     s,
-		RowsProcessed: rowsProcessed,
+		RowsProcessed: execution.RowsProcessed,
 		Duration:      duration,
 		Err:           err,
 	})
 
-	return err
+	if execution.Type == RowsExecution {
+		return err
+	}
+
+	return checkStaleQuery(execution, err, sqlBuilder.versionChecked)
+}
+
+// contextStatementImpl returns statement implementation with the table filters from the context applied
+func contextStatementImpl(ctx context.Context, statement Statement) (*serializerStatementInterfaceImpl, error) {
+	contextStatement, ok := statement.(contextStatement)
+
+	if !ok {
+		return nil, fmt.Errorf("jet: statement of type %T is not constructed using jet statement builders", statement)
+	}
+
+	return contextStatement.withContext(ctx), nil
+}
+
+func (s *serializerStatementInterfaceImpl) Query(db qrm.Queryable, destination interface{}) error {
+	return s.QueryContext(context.Background(), db, destination)
+}
+
+func (s *serializerStatementInterfaceImpl) QueryContext(ctx context.Context, db qrm.Queryable, destination interface{}) error {
+	s = s.withContext(ctx)
+	sqlBuilder := &SQLBuilder{Dialect: s.dialect}
+	query, args, err := s.build(sqlBuilder)
+
+	if err != nil {
+		return err
+	}
+
+	execution := &Execution{
+		Type:        QueryExecution,
+		Statement:   s,
+		Query:       query,
+		Args:        args,
+		Destination: destination,
+	}
+
+	err = queryContext(ctx, db, dbInterceptors(db), s.statementType, execution)
+
+	return checkStaleQuery(execution, err, sqlBuilder.versionChecked)
 }
 
 func (s *serializerStatementInterfaceImpl) Exec(db qrm.Executable) (res sql.Result, err error) {
@@ -117,44 +309,100 @@ func (s *serializerStatementInterfaceImpl) Exec(db qrm.Executable) (res sql.Resu
 }
 
 func (s *serializerStatementInterfaceImpl) ExecContext(ctx context.Context, db qrm.Executable) (res sql.Result, err error) {
-	query, args := s.Sql()
+	s = s.withContext(ctx)
+	sqlBuilder := &SQLBuilder{Dialect: s.dialect}
+	query, args, err := s.build(sqlBuilder)
 
-	callLogger(ctx, s)
+	if err != nil {
+		return nil, err
+	}
 
-	duration := duration(func() {
-		res, err = db.ExecContext(ctx, query, args...)
+	res, err = execContext(ctx, db, dbInterceptors(db), &Execution{
+		Type:      ExecExecution,
+		Statement: s,
+		Query:     query,
+		Args:      args,
 	})
 
-	var rowsAffected int64
+	return checkStaleObject(res, err, sqlBuilder.versionChecked)
+}
+
+func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.Queryable) (*Rows, error) {
+	s = s.withContext(ctx)
+	query, args, err := s.build(&SQLBuilder{Dialect: s.dialect})
 
-	if err == nil {
-		rowsAffected, _ = res.RowsAffected()
+	if err != nil {
+		return nil, err
 	}
 
-	callQueryLoggerFunc(ctx, QueryInfo{
-		Statement:     s,
-		RowsProcessed: rowsAffected,
+	return rowsContext(ctx, db, dbInterceptors(db), &Execution{
+		Type:      RowsExecution,
+		Statement: s,
+		Query:     query,
+		Args:      args,
+	})
+}
+
+func queryContext(ctx context.Context, db qrm.Queryable, interceptors []Interceptor, statementType StatementType, execution *Execution) error {
+	CallLogger(ctx, execution.Statement)
+
+	queryFunc := qrm.Query
+
+	switch statementType {
+	case SelectJsonObjStatementType:
+		queryFunc = qrm.QueryJsonObj
+	case SelectJsonArrStatementType:
+		queryFunc = qrm.QueryJsonArr
+	}
+
+	duration, err := intercept(ctx, interceptors, execution, func(ctx context.Context, execution *Execution) (err error) {
+		execution.RowsProcessed, err = queryFunc(ctx, db, execution.Query, execution.Args, execution.Destination)
+		return err
+	})
+
+	CallQueryLogger(ctx, QueryInfo{
+		Statement:     execution.Statement,
+		RowsProcessed: execution.RowsProcessed,
 		Duration:      duration,
 		Err:           err,
 	})
 
-	return res, err
+	return err
 }
 
-func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.Queryable) (*Rows, error) {
-	query, args := s.Sql()
+func execContext(ctx context.Context, db qrm.Executable, interceptors []Interceptor, execution *Execution) (sql.Result, error) {
+	CallLogger(ctx, execution.Statement)
 
-	callLogger(ctx, s)
+	duration, err := intercept(ctx, interceptors, execution, func(ctx context.Context, execution *Execution) (err error) {
+		execution.Result, err = db.ExecContext(ctx, execution.Query, execution.Args...)
 
-	var rows *sql.Rows
-	var err error
+		if err == nil {
+			execution.RowsProcessed, _ = execution.Result.RowsAffected()
+		}
 
-	duration := duration(func() {
-		rows, err = db.QueryContext(ctx, query, args...)
+		return err
 	})
 
-	callQueryLoggerFunc(ctx, QueryInfo{
-		Statement: s,
+	CallQueryLogger(ctx, QueryInfo{
+		Statement:     execution.Statement,
+		RowsProcessed: execution.RowsProcessed,
+		Duration:      duration,
+		Err:           err,
+	})
+
+	return execution.Result, err
+}
+
+func rowsContext(ctx context.Context, db qrm.Queryable, interceptors []Interceptor, execution *Execution) (*Rows, error) {
+	CallLogger(ctx, execution.Statement)
+
+	duration, err := intercept(ctx, interceptors, execution, func(ctx context.Context, execution *Execution) (err error) {
+		execution.Rows, err = db.QueryContext(ctx, execution.Query, execution.Args...)
+		return err
+	})
+
+	CallQueryLogger(ctx, QueryInfo{
+		Statement: execution.Statement,
 		Duration:  duration,
 		Err:       err,
 	})
@@ -163,14 +411,18 @@ func (s *serializerStatementInterfaceImpl) Rows(ctx context.Context, db qrm.Quer
 		return nil, err
 	}
 
-	scanContext, err := qrm.NewScanContext(rows)
+	if execution.Rows == nil {
+		return nil, errors.New("jet: statement execution has been interrupted without rows")
+	}
+
+	scanContext, err := qrm.NewScanContext(execution.Rows)
 
 	if err != nil {
 		return nil, err
 	}
 
 	return &Rows{
-		Rows:        rows,
+		Rows:        execution.Rows,
 		scanContext: scanContext,
 	}, nil
 }
@@ -186,7 +438,7 @@ func duration(f func()) time.Duration {
 // ExpressionStatement interfacess
 type ExpressionStatement interface {
 	Expression
-	Statement
+	ConfigurableStatement
 	HasProjections
 }
 
@@ -252,10 +504,18 @@ func (s *statementImpl) serialize(statement StatementType, out *SQLBuilder, opti
 		out.IncreaseIdent()
 	}
 
+	out.visit(Node{Kind: StatementNode, StatementType: s.statementType, Projections: s.parent.projections()})
+	out.depth++
+
+	out.pushTableFilterScope()
+
 	for _, clause := range s.Clauses {
-		clause.Serialize(s.statementType, out, FallTrough(options)...)
+		out.serializeClause(clause, s.statementType, FallTrough(options)...)
 	}
 
+	out.popTableFilterScope(s.statementType)
+	out.depth--
+
 	if contains(options, Ident) {
 		out.DecreaseIdent()
 		out.NewLine()
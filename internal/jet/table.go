@@ -11,6 +11,8 @@ type SerializerTable interface {
 // Table interface
 type Table interface {
 	columns() []Column
+	softDeleteColumn() ColumnExpression
+	versionColumn() ColumnExpression
 	SchemaName() string
 	TableName() string
 	Alias() string
@@ -39,11 +41,42 @@ func NewTable(schemaName, name, alias string, columns ...ColumnExpression) Seria
 	return &t
 }
 
+// NewSoftDeleteTable creates new soft delete table with schema Name, table Name, soft delete column and list of columns.
+// Rows with soft delete column set are treated as deleted: statements exclude them, and DELETE statement sets
+// soft delete column to the current timestamp instead of deleting the rows.
+func NewSoftDeleteTable(schemaName, name, alias string, softDeleteColumn ColumnExpression, columns ...ColumnExpression) SerializerTable {
+	if softDeleteColumn == nil {
+		panic("jet: soft delete column is nil")
+	}
+
+	return NewTableWithOptions(schemaName, name, alias, TableOptions{SoftDeleteColumn: softDeleteColumn}, columns...)
+}
+
+// TableOptions are optional table behaviours
+type TableOptions struct {
+	// SoftDeleteColumn makes the table soft delete table, if set. See NewSoftDeleteTable.
+	SoftDeleteColumn ColumnExpression
+	// VersionColumn is optimistic lock version column, if set. UPDATE statement setting the values from the model
+	// increments the version column, and updates the row only if its version matches the model version.
+	VersionColumn ColumnExpression
+}
+
+// NewTableWithOptions creates new table with schema Name, table Name, table options and list of columns
+func NewTableWithOptions(schemaName, name, alias string, options TableOptions, columns ...ColumnExpression) SerializerTable {
+	t := NewTable(schemaName, name, alias, columns...).(*tableImpl)
+	t.softDelete = options.SoftDeleteColumn
+	t.version = options.VersionColumn
+
+	return t
+}
+
 type tableImpl struct {
 	schemaName string
 	name       string
 	alias      string
 	columnList []ColumnExpression
+	softDelete ColumnExpression
+	version    ColumnExpression
 }
 
 func (t *tableImpl) SchemaName() string {
@@ -64,6 +97,14 @@ func (t *tableImpl) columns() []Column {
 	return ret
 }
 
+func (t *tableImpl) softDeleteColumn() ColumnExpression {
+	return t.softDelete
+}
+
+func (t *tableImpl) versionColumn() ColumnExpression {
+	return t.version
+}
+
 func (t *tableImpl) Alias() string {
 	return t.alias
 }
@@ -73,6 +114,9 @@ func (t *tableImpl) serialize(statement StatementType, out *SQLBuilder, options
 		panic("jet: tableImpl is nil")
 	}
 
+	out.visit(Node{Kind: TableNode, Schema: t.schemaName, Table: t.name, Alias: t.alias})
+	out.filterTable(t)
+
 	// Use default schema if the schema name is not set
 	if len(t.schemaName) > 0 {
 		out.WriteIdentifier(t.schemaName)
@@ -82,7 +126,54 @@ func (t *tableImpl) serialize(statement StatementType, out *SQLBuilder, options
 	out.WriteIdentifier(t.name)
 
 	if len(t.alias) > 0 {
-		out.WriteString("AS")
+		out.WriteKeyword("AS")
+		out.WriteIdentifier(t.alias)
+	}
+}
+
+// TableFunction is a function returning rows, that can be used as a table in FROM clause
+type TableFunction interface {
+	SerializerTable
+	// Arguments returns table function call arguments
+	Arguments() []Expression
+}
+
+// NewTableFunction creates new table function with schema Name, function Name, call arguments and list of result
+// columns. Columns are qualified with alias, or with function name if alias is not set.
+func NewTableFunction(schemaName, name, alias string, arguments []Expression, columns ...ColumnExpression) TableFunction {
+	return &tableFunctionImpl{
+		tableImpl: NewTable(schemaName, name, alias, columns...).(*tableImpl),
+		arguments: arguments,
+	}
+}
+
+type tableFunctionImpl struct {
+	*tableImpl
+	arguments []Expression
+}
+
+func (t *tableFunctionImpl) Arguments() []Expression {
+	return t.arguments
+}
+
+func (t *tableFunctionImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	if t == nil {
+		panic("jet: tableFunctionImpl is nil")
+	}
+
+	out.visit(Node{Kind: TableNode, Schema: t.schemaName, Table: t.name, Alias: t.alias})
+
+	if len(t.schemaName) > 0 {
+		out.WriteIdentifier(t.schemaName)
+		out.WriteString(".")
+	}
+
+	out.write([]byte(out.identifier(t.name) + "("))
+	parametersSerializer(t.arguments).serialize(statement, out)
+	out.WriteByte(')')
+
+	if len(t.alias) > 0 {
+		out.WriteKeyword("AS")
 		out.WriteIdentifier(t.alias)
 	}
 }
@@ -134,6 +225,14 @@ func (t *joinTableImpl) TableName() string {
 	return ""
 }
 
+func (t *joinTableImpl) softDeleteColumn() ColumnExpression {
+	return nil
+}
+
+func (t *joinTableImpl) versionColumn() ColumnExpression {
+	return nil
+}
+
 func (t *joinTableImpl) columns() []Column {
 	var ret []Column
 
@@ -166,29 +265,29 @@ func (t *joinTableImpl) serialize(statement StatementType, out *SQLBuilder, opti
 
 	switch t.joinType {
 	case InnerJoin:
-		out.WriteString("INNER JOIN")
+		out.WriteKeyword("INNER JOIN")
 	case LeftJoin:
-		out.WriteString("LEFT JOIN")
+		out.WriteKeyword("LEFT JOIN")
 	case RightJoin:
-		out.WriteString("RIGHT JOIN")
+		out.WriteKeyword("RIGHT JOIN")
 	case FullJoin:
-		out.WriteString("FULL JOIN")
+		out.WriteKeyword("FULL JOIN")
 	case CrossJoin:
-		out.WriteString("CROSS JOIN")
+		out.WriteKeyword("CROSS JOIN")
 	}
 
 	if is.Nil(t.rhs) {
 		panic("jet: right hand side of join operation is nil table")
 	}
 
-	t.rhs.serialize(statement, out)
-
 	if t.onCondition == nil && t.joinType != CrossJoin {
 		panic("jet: join condition is nil")
 	}
 
-	if t.onCondition != nil {
-		out.WriteString("ON")
-		t.onCondition.serialize(statement, out)
+	onCondition := out.filterJoinCondition(statement, t.rhs, t.onCondition)
+
+	if onCondition != nil {
+		out.WriteKeyword("ON")
+		onCondition.serialize(statement, out)
 	}
 }
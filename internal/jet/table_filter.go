@@ -0,0 +1,489 @@
+package jet
+
+import (
+	"context"
+	"fmt"
+)
+
+// TableFilter injects predicate into every statement referencing one of the filtered tables. Predicate is added
+// to the WHERE clause of SELECT, UPDATE and DELETE statements for the tables in FROM, UPDATE, DELETE and USING clauses,
+// and to the ON condition for the joined tables. INSERT statements into the filtered tables set the columns
+// of the insert assigments, and add the predicate to the WHERE clause of the ON CONFLICT DO UPDATE action.
+// MERGE statements add the target table predicate to the ON condition and to the WHEN NOT MATCHED BY SOURCE
+// conditions, and set the columns of the insert assigments in the WHEN NOT MATCHED THEN INSERT actions.
+// Statements to which table filter can not be applied, for instance INSERT statement with query into the table with
+// insert assigments, return an error on execution, while Sql and DebugSql panic.
+// Predicate columns of the filtered table, and columns without table name, are qualified with the table alias
+// if the table is aliased in the statement.
+type TableFilter struct {
+	tables     []Table
+	predicate  func(table Table) BoolExpression
+	assigments func(table Table) []ColumnAssigment
+}
+
+// NewTableFilter creates new table filter for the list of filtered tables. Predicate factory returns the predicate
+// for the filtered table, and optional insert assigments factory returns column assigments for the INSERT statements.
+// Assigned columns are added to the INSERT column list, or, if already in the list, their values are replaced.
+func NewTableFilter(tables []Table, predicate func(table Table) BoolExpression, insertAssigments func(table Table) []ColumnAssigment) *TableFilter {
+	if len(tables) == 0 {
+		panic("jet: table filter has to have at least one table")
+	}
+
+	if predicate == nil {
+		panic("jet: table filter predicate is nil")
+	}
+
+	return &TableFilter{
+		tables:     tables,
+		predicate:  predicate,
+		assigments: insertAssigments,
+	}
+}
+
+func (f *TableFilter) filteredTable(table Table) Table {
+	for _, filteredTable := range f.tables {
+		if filteredTable.SchemaName() == table.SchemaName() && filteredTable.TableName() == table.TableName() {
+			return filteredTable
+		}
+	}
+
+	return nil
+}
+
+type tableFiltersContextKey struct{}
+
+// ContextWithTableFilters returns a copy of context carrying the list of table filters. Table filters are applied
+// to every statement executed or prepared with the context.
+func ContextWithTableFilters(ctx context.Context, filters ...*TableFilter) context.Context {
+	return context.WithValue(ctx, tableFiltersContextKey{}, appendTableFilters(contextTableFilters(ctx), filters))
+}
+
+func contextTableFilters(ctx context.Context) []*TableFilter {
+	if ctx == nil {
+		return nil
+	}
+
+	filters, _ := ctx.Value(tableFiltersContextKey{}).([]*TableFilter)
+
+	return filters
+}
+
+func appendTableFilters(filters []*TableFilter, newFilters []*TableFilter) []*TableFilter {
+	ret := make([]*TableFilter, 0, len(filters)+len(newFilters))
+	ret = append(ret, filters...)
+
+	for _, filter := range newFilters {
+		if filter == nil {
+			panic("jet: table filter is nil")
+		}
+
+		ret = append(ret, filter)
+	}
+
+	return ret
+}
+
+// tableFilterScope collects filter and soft delete predicates, and filter assigments of the tables referenced by
+// the statement being serialized
+type tableFilterScope struct {
+	where      []BoolExpression
+	whereDone  bool
+	on         *[]BoolExpression // if set, predicates of joined table are collected for ON condition
+	insert     bool              // if set, table is INSERT statement table
+	conflict   []BoolExpression  // INSERT statement table predicates, for ON CONFLICT DO UPDATE WHERE condition
+	merge      bool              // if set, table is MERGE statement target table
+	target     []BoolExpression  // MERGE statement target table predicates, for WHEN NOT MATCHED BY SOURCE conditions
+	assigments []columnAssigmentImpl
+	columns    []string // INSERT statement column names
+	softDelete bool     // if set, DELETE statement is serialized as soft delete UPDATE statement
+}
+
+func (s *SQLBuilder) pushTableFilterScope() {
+	s.tableFilterScopes = append(s.tableFilterScopes, &tableFilterScope{})
+}
+
+func (s *SQLBuilder) popTableFilterScope(statementType StatementType) {
+	scope := s.tableFilterScope()
+
+	if scope == nil {
+		return
+	}
+
+	s.tableFilterScopes = s.tableFilterScopes[:len(s.tableFilterScopes)-1]
+
+	if len(scope.where) > 0 && !scope.whereDone && statementType != LockStatementType && statementType != UnLockStatementType {
+		s.rejectTableFilter("jet: table filter can not be applied to %s statement without WHERE clause", statementType)
+	}
+}
+
+// rejectTableFilter records the error of the table filter which can not be applied to the statement being serialized.
+// Only the first error is kept.
+func (s *SQLBuilder) rejectTableFilter(format string, args ...interface{}) {
+	s.setErr(fmt.Errorf(format, args...))
+}
+
+func (s *SQLBuilder) tableFilterScope() *tableFilterScope {
+	if len(s.tableFilterScopes) == 0 {
+		return nil
+	}
+
+	return s.tableFilterScopes[len(s.tableFilterScopes)-1]
+}
+
+// filterTable collects filter and soft delete predicates, or insert assigments for the table being serialized
+func (s *SQLBuilder) filterTable(table Table) {
+	scope := s.tableFilterScope()
+
+	if scope == nil {
+		return
+	}
+
+	addPredicate := func(predicate BoolExpression) {
+		switch {
+		case scope.insert:
+			scope.conflict = append(scope.conflict, predicate)
+		case scope.on != nil:
+			*scope.on = append(*scope.on, predicate)
+		default:
+			scope.where = append(scope.where, predicate)
+
+			if scope.merge {
+				scope.target = append(scope.target, predicate)
+			}
+		}
+	}
+
+	if softDeleteColumn := s.softDeleteColumn(table); softDeleteColumn != nil && !scope.insert {
+		addPredicate(softDeleteColumn.IS_NULL())
+	}
+
+	qualifier := table.TableName()
+
+	if table.Alias() != "" {
+		qualifier = table.Alias()
+	}
+
+	for _, filter := range s.tableFilters {
+		filteredTable := filter.filteredTable(table)
+
+		if filteredTable == nil {
+			continue
+		}
+
+		if (scope.insert || scope.merge) && filter.assigments != nil {
+			for _, assigment := range filter.assigments(filteredTable) {
+				scope.assigments = append(scope.assigments, toColumnAssigmentImpl(assigment))
+			}
+		}
+
+		addPredicate(newFilterPredicate(filter.predicate(filteredTable), filteredTable.TableName(), qualifier))
+	}
+}
+
+// softDeleteColumn returns soft delete column of the table, or nil if the table is not soft delete table or
+// statement is unscoped
+func (s *SQLBuilder) softDeleteColumn(table Table) ColumnExpression {
+	if s.unscoped || table == nil {
+		return nil
+	}
+
+	return table.softDeleteColumn()
+}
+
+// softDeleteTable marks statement being serialized as soft delete statement, if the table is soft delete table,
+// and returns soft delete column of the table
+func (s *SQLBuilder) softDeleteTable(table Table) ColumnExpression {
+	softDeleteColumn := s.softDeleteColumn(table)
+	scope := s.tableFilterScope()
+
+	if softDeleteColumn == nil || scope == nil {
+		return nil
+	}
+
+	scope.softDelete = true
+
+	return softDeleteColumn
+}
+
+func (s *SQLBuilder) isSoftDelete() bool {
+	scope := s.tableFilterScope()
+
+	return scope != nil && scope.softDelete
+}
+
+// filterJoinCondition serializes joined table and returns join condition extended with the joined table predicates
+func (s *SQLBuilder) filterJoinCondition(statement StatementType, table Serializer, onCondition BoolExpression) BoolExpression {
+	scope := s.tableFilterScope()
+
+	if scope == nil {
+		table.serialize(statement, s)
+		return onCondition
+	}
+
+	var predicates []BoolExpression
+
+	on := scope.on
+	scope.on = &predicates
+	table.serialize(statement, s)
+	scope.on = on
+
+	if onCondition == nil { // CROSS JOIN, predicates are added to WHERE clause
+		scope.where = append(scope.where, predicates...)
+		return nil
+	}
+
+	return and(onCondition, predicates)
+}
+
+// filterWhereCondition returns WHERE condition extended with the predicates of the filtered tables
+func (s *SQLBuilder) filterWhereCondition(condition BoolExpression) BoolExpression {
+	scope := s.tableFilterScope()
+
+	if scope == nil || scope.whereDone {
+		return condition
+	}
+
+	scope.whereDone = true
+
+	if condition == nil {
+		if len(scope.where) == 0 {
+			return nil
+		}
+
+		return and(scope.where[0], scope.where[1:])
+	}
+
+	return and(condition, scope.where)
+}
+
+// filterConflictCondition returns ON CONFLICT DO UPDATE WHERE condition extended with the predicates of the filtered
+// INSERT statement table
+func (s *SQLBuilder) filterConflictCondition(condition BoolExpression) BoolExpression {
+	scope := s.tableFilterScope()
+
+	if scope == nil || len(scope.conflict) == 0 {
+		return condition
+	}
+
+	if condition == nil {
+		return and(scope.conflict[0], scope.conflict[1:])
+	}
+
+	return and(condition, scope.conflict)
+}
+
+// RejectInsertTableFilter records an error if the INSERT statement table is filtered, for the clauses which can not
+// be extended with the table filter predicate, for instance MySQL ON DUPLICATE KEY UPDATE clause.
+func (s *SQLBuilder) RejectInsertTableFilter(clauseName string) {
+	scope := s.tableFilterScope()
+
+	if scope != nil && len(scope.conflict) > 0 {
+		s.rejectTableFilter("jet: table filter can not be applied to %s clause", clauseName)
+	}
+}
+
+// filterMergeTable serializes MERGE statement target and source tables, and collects predicates and insert assigments
+// of the filtered target table
+func (s *SQLBuilder) filterMergeTable(statement StatementType, table SerializerTable, using Serializer, options ...SerializeOption) {
+	scope := s.tableFilterScope()
+
+	if scope == nil {
+		table.serialize(statement, s, options...)
+		s.NewLine()
+		s.WriteKeyword("USING")
+		using.serialize(statement, s, options...)
+		return
+	}
+
+	scope.merge = true
+	table.serialize(statement, s, options...)
+	scope.merge = false
+
+	s.NewLine()
+	s.WriteKeyword("USING")
+
+	targetPredicates := len(scope.where)
+	using.serialize(statement, s, options...)
+
+	if len(scope.where) > targetPredicates {
+		s.rejectTableFilter("jet: table filter can not be applied to MERGE statement USING table, use sub-query instead")
+	}
+}
+
+// FilterMergeCondition returns MERGE statement WHEN NOT MATCHED BY SOURCE condition extended with the predicates
+// of the filtered target table.
+func (s *SQLBuilder) FilterMergeCondition(condition BoolExpression) BoolExpression {
+	scope := s.tableFilterScope()
+
+	if scope == nil || len(scope.target) == 0 {
+		return condition
+	}
+
+	if condition == nil {
+		return and(scope.target[0], scope.target[1:])
+	}
+
+	return and(condition, scope.target)
+}
+
+// FilterMergeInsert returns MERGE statement WHEN NOT MATCHED THEN INSERT columns and values, with the values
+// of the filtered target table insert assigments set.
+func (s *SQLBuilder) FilterMergeInsert(columns []Column, values []Serializer) ([]Column, []Serializer) {
+	scope := s.tableFilterScope()
+
+	if scope == nil || len(scope.assigments) == 0 {
+		return columns, values
+	}
+
+	if len(columns) == 0 {
+		s.rejectTableFilter("jet: table filter assigments can not be applied to MERGE INSERT action without column list")
+		return columns, values
+	}
+
+	scope.columns = nil
+
+	for _, column := range columns {
+		scope.columns = append(scope.columns, column.Name())
+	}
+
+	columns = append(columns[:len(columns):len(columns)], s.filterInsertColumns(true)...)
+
+	return columns, s.filterInsertRow(values)
+}
+
+// filterInsertTable serializes INSERT statement table and collects insert assigments of the filtered table
+func (s *SQLBuilder) filterInsertTable(statement StatementType, table SerializerTable, columns []Column) {
+	scope := s.tableFilterScope()
+
+	if scope == nil {
+		table.serialize(statement, s)
+		return
+	}
+
+	scope.insert = true
+	table.serialize(statement, s)
+	scope.insert = false
+
+	if len(s.tableFilters) == 0 {
+		return
+	}
+
+	for _, column := range columns {
+		scope.columns = append(scope.columns, column.Name())
+	}
+}
+
+// filterInsertColumns returns the list of the additional INSERT statement columns, set by the insert assigments
+func (s *SQLBuilder) filterInsertColumns(explicitColumns bool) []Column {
+	scope := s.tableFilterScope()
+
+	if scope == nil {
+		return nil
+	}
+
+	var ret []Column
+
+	for _, assigment := range scope.assigments {
+		if indexOf(scope.columns, assigment.column.Name()) >= 0 {
+			continue
+		}
+
+		if !explicitColumns {
+			s.rejectTableFilter("jet: table filter column '%s' is not a table column", assigment.column.Name())
+			continue
+		}
+
+		scope.columns = append(scope.columns, assigment.column.Name())
+		ret = append(ret, assigment.column)
+	}
+
+	return ret
+}
+
+// filterInsertRow returns INSERT statement row with the values of the insert assigments set
+func (s *SQLBuilder) filterInsertRow(row []Serializer) []Serializer {
+	scope := s.tableFilterScope()
+
+	if scope == nil || len(scope.assigments) == 0 {
+		return row
+	}
+
+	ret := make([]Serializer, len(scope.columns))
+	copy(ret, row)
+
+	for _, assigment := range scope.assigments {
+		if index := indexOf(scope.columns, assigment.column.Name()); index >= 0 {
+			ret[index] = assigment.expression
+		}
+	}
+
+	return ret
+}
+
+func (s *SQLBuilder) filterInsertQuery() {
+	scope := s.tableFilterScope()
+
+	if scope != nil && len(scope.assigments) > 0 {
+		s.rejectTableFilter("jet: table filter assigments can not be applied to INSERT statement with query")
+	}
+}
+
+func toColumnAssigmentImpl(assigment ColumnAssigment) columnAssigmentImpl {
+	impl, ok := assigment.(columnAssigmentImpl)
+
+	if !ok || impl.column == nil {
+		panic("jet: table filter assigment has to be single column assigment")
+	}
+
+	return impl
+}
+
+func and(condition BoolExpression, conditions []BoolExpression) BoolExpression {
+	for _, c := range conditions {
+		condition = condition.AND(c)
+	}
+
+	return condition
+}
+
+func indexOf(list []string, value string) int {
+	for i, v := range list {
+		if v == value {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// filterPredicate serializes filter predicate with the filtered table columns qualified with the table alias
+type filterPredicate struct {
+	ExpressionInterfaceImpl
+
+	predicate BoolExpression
+	tableName string
+	qualifier string
+}
+
+func newFilterPredicate(predicate BoolExpression, tableName, qualifier string) BoolExpression {
+	if predicate == nil {
+		panic("jet: table filter predicate returned nil expression")
+	}
+
+	ret := &filterPredicate{
+		predicate: predicate,
+		tableName: tableName,
+		qualifier: qualifier,
+	}
+	ret.ExpressionInterfaceImpl.Parent = ret
+
+	return BoolExp(ret)
+}
+
+func (f *filterPredicate) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	qualifiers := out.columnQualifiers
+
+	out.columnQualifiers = map[string]string{f.tableName: f.qualifier, "": f.qualifier}
+	f.predicate.serialize(statement, out, options...)
+	out.columnQualifiers = qualifiers
+}
@@ -1,6 +1,7 @@
 package jet
 
 import (
+	"database/sql/driver"
 	"reflect"
 	"strings"
 
@@ -69,6 +70,7 @@ func SerializeColumnNames(columns []Column, out *SQLBuilder) {
 			panic("jet: nil column in columns list")
 		}
 
+		out.visit(Node{Kind: ColumnNode, Table: col.TableName(), Column: col.Name()})
 		out.WriteIdentifier(col.Name())
 	}
 }
@@ -172,12 +174,28 @@ func UnwindRowFromModel(columns []Column, data interface{}) []Serializer {
 			field = reflect.Indirect(structField).Interface()
 		}
 
+		if isNullValuer(field) {
+			field = nil
+		}
+
 		row = append(row, literal(field))
 	}
 
 	return row
 }
 
+// isNullValuer returns true if the value is a nullable type, like sql.NullString or qrm.Null, holding NULL
+func isNullValuer(value interface{}) bool {
+	valuer, ok := value.(driver.Valuer)
+	if !ok {
+		return false
+	}
+
+	driverValue, err := valuer.Value()
+
+	return err == nil && driverValue == nil
+}
+
 // UnwindRowsFromModels func
 func UnwindRowsFromModels(columns []Column, data interface{}) [][]Serializer {
 	sliceValue := reflect.Indirect(reflect.ValueOf(data))
@@ -0,0 +1,281 @@
+package jet
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NodeKind is a kind of the statement tree node
+type NodeKind string
+
+// List of statement tree node kinds
+const (
+	StatementNode NodeKind = "STATEMENT"
+	ClauseNode    NodeKind = "CLAUSE"
+	TableNode     NodeKind = "TABLE"
+	ColumnNode    NodeKind = "COLUMN"
+	LiteralNode   NodeKind = "LITERAL"
+)
+
+// Node is a statement tree node passed to the Visitor. Only the fields of the node kind are set.
+type Node struct {
+	Kind NodeKind
+	// Depth is statement nesting level of the node. Main statement and its clauses, tables, columns and literals
+	// are at depth 0, while sub-queries and CTE definitions are at the higher depths.
+	Depth int
+
+	StatementType StatementType  // statement node type
+	Projections   ProjectionList // statement node projections
+
+	Clause     Clause // clause node
+	ClauseName string // clause node name, for instance WHERE or ORDER BY
+
+	Schema string // table node schema name
+	Table  string // table node name, or column node table name (or alias if table is aliased)
+	Alias  string // table node alias
+	Column string // column node name
+
+	Value interface{} // literal node value
+}
+
+// Visitor is a function called for each node of the statement tree
+type Visitor func(node Node)
+
+// NamedClause is dialect specific clause which reports its name to the statement tree visitor
+type NamedClause interface {
+	Clause
+	ClauseName() string
+}
+
+// walkableStatement is implemented by all the jet statements. Dialect statements expose walk method through
+// the embedded ConfigurableStatement interface.
+type walkableStatement interface {
+	walk(visitor Visitor)
+}
+
+// Walk calls visitor for each statement, clause, table, column and literal of the statement tree, including
+// sub-queries and CTEs, in the same order they appear in the serialized statement. Clauses not present
+// in the serialized statement (for instance WHERE clause without condition) are not visited.
+// Walk panics if the statement is not constructed using jet statement builders.
+func Walk(statement Statement, visitor Visitor) {
+	if statement == nil {
+		panic("jet: statement is nil")
+	}
+
+	walkable, ok := statement.(walkableStatement)
+
+	if !ok {
+		panic(fmt.Sprintf("jet: statement of type %T can not be walked", statement))
+	}
+
+	walkable.walk(visitor)
+}
+
+func (s *serializerStatementInterfaceImpl) walk(visitor Visitor) {
+	// statement is serialized as parametrized query, so literal values are passed to the visitor without conversion
+	// to the debug sql string representation
+	sqlBuilder := &SQLBuilder{Dialect: s.dialect, allowUnboundParams: true, visitor: visitor}
+
+	s.build(sqlBuilder)
+}
+
+// TableReference is a table referenced by the statement
+type TableReference struct {
+	Schema string
+	Name   string
+	Alias  string
+}
+
+// ColumnReference is a column referenced by the statement
+type ColumnReference struct {
+	Table string // table name, or table alias if table is aliased
+	Name  string
+}
+
+// StatementInfo contains information about the statement, collected by walking statement tree
+type StatementInfo struct {
+	// Type is the type of the main statement. For WITH statements, it is the type of the primary statement.
+	Type StatementType
+	// Writes is true if the statement or any of its sub-statements is INSERT, UPDATE, DELETE, MERGE or CALL statement.
+	Writes bool
+	// Clauses are the names of the main statement clauses
+	Clauses []string
+	// Tables and Columns are unique tables and columns referenced by the statement and its sub-statements.
+	Tables  []TableReference
+	Columns []ColumnReference
+	// Literals are all the literal values of the statement, including sub-statements literals.
+	Literals []interface{}
+	// Projections are the main statement projections
+	Projections ProjectionList
+}
+
+// HasClause returns true if the main statement contains clause with the name
+func (s StatementInfo) HasClause(name string) bool {
+	for _, clause := range s.Clauses {
+		if clause == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Inspect walks the statement tree and returns collected statement information
+func Inspect(statement Statement) StatementInfo {
+	var info StatementInfo
+
+	tables := map[TableReference]bool{}
+	columns := map[ColumnReference]bool{}
+
+	Walk(statement, func(node Node) {
+		switch node.Kind {
+		case StatementNode:
+			switch node.StatementType {
+			case InsertStatementType, UpdateStatementType, DeleteStatementType, MergeStatementType, CallStatementType:
+				info.Writes = true
+			}
+
+			if node.Depth == 0 && node.StatementType != WithStatementType {
+				info.Type = node.StatementType
+				info.Projections = node.Projections
+				info.Clauses = nil
+			}
+		case ClauseNode:
+			if node.Depth == 0 {
+				info.Clauses = append(info.Clauses, node.ClauseName)
+			}
+		case TableNode:
+			table := TableReference{Schema: node.Schema, Name: node.Table, Alias: node.Alias}
+
+			if !tables[table] {
+				tables[table] = true
+				info.Tables = append(info.Tables, table)
+			}
+		case ColumnNode:
+			column := ColumnReference{Table: node.Table, Name: node.Column}
+
+			if !columns[column] {
+				columns[column] = true
+				info.Columns = append(info.Columns, column)
+			}
+		case LiteralNode:
+			info.Literals = append(info.Literals, node.Value)
+		}
+	})
+
+	return info
+}
+
+func (s *SQLBuilder) visit(node Node) {
+	if s.visitor == nil {
+		return
+	}
+
+	node.Depth = s.depth
+
+	if node.Kind != StatementNode && node.Depth > 0 {
+		node.Depth-- // nodes belong to the statement serialized at the previous depth
+	}
+
+	s.visitor(node)
+}
+
+// serializeClause serializes clause and visits it, if it is present in the serialized statement.
+// Clause node is visited before the nodes of the clause, so the nodes visited during clause serialization are
+// buffered until it is known if clause is present.
+func (s *SQLBuilder) serializeClause(clause Clause, statementType StatementType, options ...SerializeOption) {
+	if s.visitor == nil {
+		clause.Serialize(statementType, s, options...)
+		return
+	}
+
+	visitor := s.visitor
+	var nodes []Node
+
+	s.visitor = func(node Node) {
+		nodes = append(nodes, node)
+	}
+
+	start := s.Buff.Len()
+	clause.Serialize(statementType, s, options...)
+	written := s.Buff.Len() > start
+
+	s.visitor = visitor
+
+	if !written {
+		return
+	}
+
+	s.visit(Node{Kind: ClauseNode, Clause: clause, ClauseName: clauseName(clause)})
+
+	for _, node := range nodes {
+		visitor(node)
+	}
+}
+
+func clauseName(clause Clause) string {
+	switch c := clause.(type) {
+	case NamedClause:
+		return c.ClauseName()
+	case *ClauseSelect:
+		return "SELECT"
+	case *ClauseFrom:
+		if c.Name != "" {
+			return c.Name
+		}
+		return "FROM"
+	case *ClauseWhere:
+		return "WHERE"
+	case *ClauseGroupBy:
+		return "GROUP BY"
+	case *ClauseHaving:
+		return "HAVING"
+	case *ClauseWindow:
+		return "WINDOW"
+	case *ClauseOrderBy:
+		return "ORDER BY"
+	case *ClauseLimit:
+		return "LIMIT"
+	case *ClauseOffset:
+		return "OFFSET"
+	case *ClauseFetch:
+		return "FETCH"
+	case *ClauseFor:
+		return "FOR"
+	case *ClauseSetStmtOperator:
+		return c.Operator
+	case *ClauseUpdate:
+		return "UPDATE"
+	case *SetClause, SetClauseNew:
+		return "SET"
+	case *ClauseInsert:
+		return "INSERT"
+	case *ClauseValuesQuery:
+		if c.Query != nil {
+			return "QUERY"
+		}
+		return "VALUES"
+	case *ClauseValues:
+		return "VALUES"
+	case *ClauseQuery:
+		return "QUERY"
+	case *ClauseDelete:
+		return "DELETE"
+	case *ClauseStatementBegin:
+		return c.Name
+	case *ClauseOptional:
+		return c.Name
+	case *ClauseIn:
+		return "IN"
+	case *ClauseReturning:
+		return "RETURNING"
+	case *ClauseOutput:
+		return "OUTPUT"
+	case *ClauseMerge:
+		return "MERGE"
+	case KeywordClause:
+		return strings.TrimSpace(string(c.Keyword))
+	}
+
+	return ""
+}
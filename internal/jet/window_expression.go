@@ -16,7 +16,7 @@ func (w *commonWindowImpl) over(window ...Window) {
 func (w *commonWindowImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
 	w.expression.serialize(statement, out)
 	if w.window != nil {
-		out.WriteString("OVER")
+		out.WriteKeyword("OVER")
 		w.window.serialize(statement, out, FallTrough(options)...)
 	}
 }
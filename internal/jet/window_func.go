@@ -35,7 +35,7 @@ func (w *windowImpl) serialize(statement StatementType, out *SQLBuilder, options
 	}
 
 	if w.partitionBy != nil {
-		out.WriteString("PARTITION BY")
+		out.WriteKeyword("PARTITION BY")
 
 		serializeExpressionList(statement, w.partitionBy, ", ", out)
 	}
@@ -43,14 +43,14 @@ func (w *windowImpl) serialize(statement StatementType, out *SQLBuilder, options
 	w.orderBy.Serialize(statement, out, FallTrough(options)...)
 
 	if w.frameUnits != "" {
-		out.WriteString(w.frameUnits)
+		out.WriteKeyword(w.frameUnits)
 
 		if w.end == nil {
 			w.start.serialize(statement, out)
 		} else {
-			out.WriteString("BETWEEN")
+			out.WriteKeyword("BETWEEN")
 			w.start.serialize(statement, out)
-			out.WriteString("AND")
+			out.WriteKeyword("AND")
 			w.end.serialize(statement, out)
 		}
 	}
@@ -142,9 +142,9 @@ func (f *frameExtentImpl) serialize(statement StatementType, out *SQLBuilder, op
 	f.offset.serialize(statement, out, FallTrough(options)...)
 
 	if f.preceding {
-		out.WriteString("PRECEDING")
+		out.WriteKeyword("PRECEDING")
 	} else {
-		out.WriteString("FOLLOWING")
+		out.WriteKeyword("FOLLOWING")
 	}
 }
 
@@ -3,23 +3,25 @@ package jet
 import "fmt"
 
 // WITH function creates new with statement from list of common table expressions for specified dialect
-func WITH(dialect Dialect, recursive bool, cte ...*CommonTableExpression) func(statement Statement) Statement {
-	newWithImpl := &withImpl{
-		recursive: recursive,
-		ctes:      cte,
-		serializerStatementInterfaceImpl: serializerStatementInterfaceImpl{
-			dialect:       dialect,
-			statementType: WithStatementType,
-		},
-	}
-	newWithImpl.parent = newWithImpl
-
-	return func(primaryStatement Statement) Statement {
+func WITH(dialect Dialect, recursive bool, cte ...*CommonTableExpression) func(statement Statement) ConfigurableStatement {
+	return func(primaryStatement Statement) ConfigurableStatement {
 		serializerStatement, ok := primaryStatement.(SerializerStatement)
 		if !ok {
 			panic("jet: unsupported main WITH statement.")
 		}
-		newWithImpl.primaryStatement = serializerStatement
+
+		// new statement is created for each primary statement, so the same WITH function can be reused
+		newWithImpl := &withImpl{
+			recursive:        recursive,
+			ctes:             cte,
+			primaryStatement: serializerStatement,
+			serializerStatementInterfaceImpl: serializerStatementInterfaceImpl{
+				dialect:       dialect,
+				statementType: WithStatementType,
+			},
+		}
+		newWithImpl.parent = newWithImpl
+
 		return newWithImpl
 	}
 }
@@ -32,11 +34,13 @@ type withImpl struct {
 }
 
 func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ...SerializeOption) {
+	out.visit(Node{Kind: StatementNode, StatementType: WithStatementType})
+
 	out.NewLine()
-	out.WriteString("WITH")
+	out.WriteKeyword("WITH")
 
 	if w.recursive {
-		out.WriteString("RECURSIVE")
+		out.WriteKeyword("RECURSIVE")
 	}
 
 	for i, cte := range w.ctes {
@@ -44,7 +48,9 @@ func (w withImpl) serialize(statement StatementType, out *SQLBuilder, options ..
 			out.WriteString(",")
 		}
 
+		out.depth++ // CTE definitions are sub-statements of the primary statement
 		cte.serialize(statement, out, FallTrough(options)...)
+		out.depth--
 	}
 	w.primaryStatement.serialize(statement, out, NoWrap.WithFallTrough(options)...)
 }
@@ -83,10 +89,10 @@ func (c CommonTableExpression) serialize(statement StatementType, out *SQLBuilde
 			SerializeColumnExpressionNames(c.Columns, out)
 			out.WriteByte(')')
 		}
-		out.WriteString("AS")
+		out.WriteKeyword("AS")
 
 		if c.NotMaterialized {
-			out.WriteString("NOT MATERIALIZED")
+			out.WriteKeyword("NOT MATERIALIZED")
 		}
 
 		if c.Statement == nil {
@@ -0,0 +1,67 @@
+package typeconv
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"sync"
+)
+
+// Converter converts values of a registered Go type from and to database driver values
+type Converter struct {
+	// FromDriver converts scanned database value into the value of registered Go type
+	FromDriver func(value interface{}) (reflect.Value, error)
+	// ToDriver converts value of registered Go type into database driver value
+	ToDriver func(value interface{}) (driver.Value, error)
+}
+
+var (
+	mutex      sync.RWMutex
+	converters = map[reflect.Type]Converter{}
+)
+
+// Register registers converter for the goType. Previously registered converter for the same type is replaced.
+func Register(goType reflect.Type, converter Converter) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	converters[goType] = converter
+}
+
+// Unregister removes converter registered for the goType
+func Unregister(goType reflect.Type) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	delete(converters, goType)
+}
+
+// Lookup returns converter registered for the goType
+func Lookup(goType reflect.Type) (Converter, bool) {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	converter, ok := converters[goType]
+	return converter, ok
+}
+
+// HasFromDriver returns true if there is a converter from driver values registered for the goType
+func HasFromDriver(goType reflect.Type) bool {
+	converter, ok := Lookup(goType)
+	return ok && converter.FromDriver != nil
+}
+
+// ToDriverValue converts value into driver value, if there is a converter registered for the value type.
+// Otherwise, value is returned unchanged.
+func ToDriverValue(value interface{}) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	converter, ok := Lookup(reflect.TypeOf(value))
+
+	if !ok || converter.ToDriver == nil {
+		return value, nil
+	}
+
+	return converter.ToDriver(value)
+}
@@ -13,7 +13,7 @@ type cast interface {
 	// Cast expression AS date type
 	AS_DATE() DateExpression
 	// Cast expression AS numeric type, using precision and optionally scale
-	AS_DECIMAL() FloatExpression
+	AS_DECIMAL() DecimalExpression
 	// Cast expression AS time type
 	AS_TIME() TimeExpression
 	// Cast expression as datetime type
@@ -74,8 +74,8 @@ func (c *castImpl) AS_DATE() DateExpression {
 }
 
 // AS_DECIMAL casts expression AS DECIMAL type
-func (c *castImpl) AS_DECIMAL() FloatExpression {
-	return FloatExp(c.AS("DECIMAL"))
+func (c *castImpl) AS_DECIMAL() DecimalExpression {
+	return DecimalExp(c.AS("DECIMAL"))
 }
 
 // AS_TIME casts expression AS TIME type
@@ -33,6 +33,12 @@ type ColumnFloat = jet.ColumnFloat
 // FloatColumn creates named float column.
 var FloatColumn = jet.FloatColumn
 
+// ColumnDecimal is interface for SQL numeric or decimal column.
+type ColumnDecimal = jet.ColumnDecimal
+
+// DecimalColumn creates named decimal column.
+var DecimalColumn = jet.DecimalColumn
+
 // ColumnTime is interface for SQL time column.
 type ColumnTime = jet.ColumnTime
 
@@ -4,7 +4,7 @@ import "github.com/go-jet/jet/v2/internal/jet"
 
 // DeleteStatement is interface for MySQL DELETE statement
 type DeleteStatement interface {
-	Statement
+	ConfigurableStatement
 
 	OPTIMIZER_HINTS(hints ...OptimizerHint) DeleteStatement
 
@@ -12,6 +12,10 @@ type DeleteStatement interface {
 	WHERE(expression BoolExpression) DeleteStatement
 	ORDER_BY(orderByClauses ...OrderByClause) DeleteStatement
 	LIMIT(limit int64) DeleteStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() DeleteStatement
 }
 
 type deleteStatementImpl struct {
@@ -26,20 +30,31 @@ type deleteStatementImpl struct {
 
 func newDeleteStatement(table Table) DeleteStatement {
 	newDelete := &deleteStatementImpl{}
-	newDelete.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, newDelete,
-		&newDelete.Delete,
-		&newDelete.Using,
-		&newDelete.Where,
-		&newDelete.OrderBy,
-		&newDelete.Limit,
-	)
-
 	newDelete.Delete.Table = table
 	newDelete.Using.Name = "USING"
 	newDelete.Where.Mandatory = true
 	newDelete.Limit.Count = -1
 
-	return newDelete
+	return newDelete.init()
+}
+
+func (d *deleteStatementImpl) init() *deleteStatementImpl {
+	d.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, d,
+		&d.Delete,
+		&d.Using,
+		&d.Where,
+		&d.OrderBy,
+		&d.Limit,
+	)
+
+	return d
+}
+
+func (d *deleteStatementImpl) Clone() DeleteStatement {
+	newDelete := *d
+	jet.CloneSlices(&newDelete)
+
+	return newDelete.init()
 }
 
 func (d *deleteStatementImpl) OPTIMIZER_HINTS(hints ...OptimizerHint) DeleteStatement {
@@ -55,7 +55,7 @@ func mysqlCONCAToperator(expressions ...jet.Serializer) jet.SerializerFunc {
 		if len(expressions) < 2 {
 			panic("jet: invalid number of expressions for operator CONCAT")
 		}
-		out.WriteString("CONCAT(")
+		out.WriteFunctionCall("CONCAT")
 
 		jet.Serialize(expressions[0], statement, out, options...)
 
@@ -82,7 +82,7 @@ func mysqlDivision(expressions ...jet.Serializer) jet.SerializerFunc {
 		_, isRhsInt := rhs.(IntegerExpression)
 
 		if isLhsInt && isRhsInt {
-			out.WriteString("DIV")
+			out.WriteKeyword("DIV")
 		} else {
 			out.WriteString("/")
 		}
@@ -105,7 +105,7 @@ func mysqlISNOTDISTINCTFROM(expressions ...jet.Serializer) jet.SerializerFunc {
 
 func mysqlISDISTINCTFROM(expressions ...jet.Serializer) jet.SerializerFunc {
 	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
-		out.WriteString("NOT(")
+		out.WriteFunctionCall("NOT")
 		mysqlISNOTDISTINCTFROM(expressions...)(statement, out, options...)
 		out.WriteString(")")
 	}
@@ -127,10 +127,10 @@ func mysqlREGEXPLIKEoperator(expressions ...jet.Serializer) jet.SerializerFunc {
 			}
 		}
 
-		out.WriteString("REGEXP")
+		out.WriteKeyword("REGEXP")
 
 		if caseSensitive {
-			out.WriteString("BINARY")
+			out.WriteKeyword("BINARY")
 		}
 
 		jet.Serialize(expressions[1], statement, out, options...)
@@ -153,10 +153,10 @@ func mysqlNOTREGEXPLIKEoperator(expressions ...jet.Serializer) jet.SerializerFun
 			}
 		}
 
-		out.WriteString("NOT REGEXP")
+		out.WriteKeyword("NOT REGEXP")
 
 		if caseSensitive {
-			out.WriteString("BINARY")
+			out.WriteKeyword("BINARY")
 		}
 
 		jet.Serialize(expressions[1], statement, out, options...)
@@ -204,9 +204,9 @@ func serializeOrderBy(expression Expression, ascending, nullsFirst *bool) jet.Se
 
 func serializeAscending(ascending bool, out *jet.SQLBuilder) {
 	if ascending {
-		out.WriteString("ASC")
+		out.WriteKeyword("ASC")
 	} else {
-		out.WriteString("DESC")
+		out.WriteKeyword("DESC")
 	}
 }
 
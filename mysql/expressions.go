@@ -18,6 +18,9 @@ type IntegerExpression = jet.IntegerExpression
 // FloatExpression interface
 type FloatExpression = jet.FloatExpression
 
+// DecimalExpression is interface for numeric and decimal expressions
+type DecimalExpression = jet.DecimalExpression
+
 // TimeExpression interface
 type TimeExpression = jet.TimeExpression
 
@@ -50,6 +53,11 @@ var IntExp = jet.IntExp
 // Does not add sql cast to generated sql builder output.
 var FloatExp = jet.FloatExp
 
+// DecimalExp is decimal expression wrapper around arbitrary expression.
+// Allows go compiler to see any expression as decimal expression.
+// Does not add sql cast to generated sql builder output.
+var DecimalExp = jet.DecimalExp
+
 // TimeExp is time expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as time expression.
 // Does not add sql cast to generated sql builder output.
@@ -228,6 +228,14 @@ func UUID_TO_BIN(str StringExpression) StringExpression {
 	return StringExp(fn)
 }
 
+// FIND_IN_SET returns position (1-based) of the str in the comma separated list of strings strList, or 0 if str is
+// not in strList. It is usually used to check SET column members.
+//
+//	FIND_IN_SET(String("admin"), User.Roles).GT(Int(0))
+func FIND_IN_SET(str, strList StringExpression) IntegerExpression {
+	return IntExp(Func("FIND_IN_SET", str, strList))
+}
+
 //----------------- Date/Time Functions and Operators ------------//
 
 // EXTRACT function retrieves subfields such as year or hour from date/time values
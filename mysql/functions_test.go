@@ -9,3 +9,9 @@ import (
 func TestUUIDToBin(t *testing.T) {
 	assertSerialize(t, UUID_TO_BIN(String(uuid.Nil.String())), `uuid_to_bin(?)`, uuid.Nil.String())
 }
+
+func TestFIND_IN_SET(t *testing.T) {
+	assertSerialize(t, FIND_IN_SET(String("admin"), table1ColString), `FIND_IN_SET(?, table1.col_string)`, "admin")
+	assertSerialize(t, FIND_IN_SET(table2ColStr, table1ColString).GT(Int(0)),
+		`(FIND_IN_SET(table2.col_str, table1.col_string) > ?)`, int64(0))
+}
@@ -4,7 +4,7 @@ import "github.com/go-jet/jet/v2/internal/jet"
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
-	Statement
+	ConfigurableStatement
 
 	OPTIMIZER_HINTS(hints ...OptimizerHint) InsertStatement
 
@@ -19,20 +19,35 @@ type InsertStatement interface {
 	ON_DUPLICATE_KEY_UPDATE(assigments ...ColumnAssigment) InsertStatement
 
 	QUERY(selectStatement SelectStatement) InsertStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() InsertStatement
 }
 
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
 	newInsert := &insertStatementImpl{}
-	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
-		&newInsert.Insert,
-		&newInsert.ValuesQuery,
-		&newInsert.OnDuplicateKey,
-	)
-
 	newInsert.Insert.Table = table
 	newInsert.Insert.Columns = columns
 
-	return newInsert
+	return newInsert.init()
+}
+
+func (is *insertStatementImpl) init() *insertStatementImpl {
+	is.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, is,
+		&is.Insert,
+		&is.ValuesQuery,
+		&is.OnDuplicateKey,
+	)
+
+	return is
+}
+
+func (is *insertStatementImpl) Clone() InsertStatement {
+	newInsert := *is
+	jet.CloneSlices(&newInsert)
+
+	return newInsert.init()
 }
 
 type insertStatementImpl struct {
@@ -80,13 +95,21 @@ func (is *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStat
 
 type onDuplicateKeyUpdateClause []jet.ColumnAssigment
 
+// ClauseName returns clause name
+func (s onDuplicateKeyUpdateClause) ClauseName() string {
+	return "ON DUPLICATE KEY UPDATE"
+}
+
 // Serialize for SetClause
 func (s onDuplicateKeyUpdateClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 	if len(s) == 0 {
 		return
 	}
+
+	out.RejectInsertTableFilter("ON DUPLICATE KEY UPDATE")
+
 	out.NewLine()
-	out.WriteString("ON DUPLICATE KEY UPDATE")
+	out.WriteKeyword("ON DUPLICATE KEY UPDATE")
 	out.IncreaseIdent(24)
 
 	for i, assigment := range s {
@@ -4,9 +4,13 @@ import "github.com/go-jet/jet/v2/internal/jet"
 
 // LockStatement is interface for MySQL LOCK tables
 type LockStatement interface {
-	Statement
-	READ() Statement
-	WRITE() Statement
+	ConfigurableStatement
+	READ() ConfigurableStatement
+	WRITE() ConfigurableStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() LockStatement
 }
 
 // LOCK creates LockStatement from list of tables
@@ -17,9 +21,20 @@ func LOCK(tables ...jet.SerializerTable) LockStatement {
 		Write: jet.ClauseOptional{Name: "WRITE"},
 	}
 
-	newLock.SerializerStatement = jet.NewStatementImpl(Dialect, jet.LockStatementType, newLock, &newLock.Lock, &newLock.Read, &newLock.Write)
+	return newLock.init()
+}
+
+func (l *lockStatementImpl) init() *lockStatementImpl {
+	l.SerializerStatement = jet.NewStatementImpl(Dialect, jet.LockStatementType, l, &l.Lock, &l.Read, &l.Write)
+
+	return l
+}
+
+func (l *lockStatementImpl) Clone() LockStatement {
+	newLock := *l
+	jet.CloneSlices(&newLock)
 
-	return newLock
+	return newLock.init()
 }
 
 type lockStatementImpl struct {
@@ -30,18 +45,18 @@ type lockStatementImpl struct {
 	Write jet.ClauseOptional
 }
 
-func (l *lockStatementImpl) READ() Statement {
+func (l *lockStatementImpl) READ() ConfigurableStatement {
 	l.Read.Show = true
 	return l
 }
 
-func (l *lockStatementImpl) WRITE() Statement {
+func (l *lockStatementImpl) WRITE() ConfigurableStatement {
 	l.Write.Show = true
 	return l
 }
 
 // UNLOCK_TABLES explicitly releases any table locks held by the current session
-func UNLOCK_TABLES() Statement {
+func UNLOCK_TABLES() ConfigurableStatement {
 	newUnlock := &unlockStatementImpl{
 		Unlock: jet.ClauseStatementBegin{Name: "UNLOCK TABLES"},
 	}
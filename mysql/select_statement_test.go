@@ -110,6 +110,16 @@ FROM db.table2
 LIMIT ?
 OFFSET ?;
 `, int64(10), int64(2))
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_e(IntParam("limit")).WithArgs(map[string]interface{}{"limit": 5}), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT ?;
+`, 5)
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_e(Int(3)).LIMIT(10), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT ?;
+`, int64(10))
 }
 
 func TestSelectLock(t *testing.T) {
@@ -159,3 +169,31 @@ WHERE NOT (EXISTS (
       ));
 `)
 }
+
+func TestSelectClone(t *testing.T) {
+	base := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.GT(Int(1)))
+
+	assertStatementSql(t, base.Clone().WHERE(table1ColBool.IS_TRUE()).LIMIT(10), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_bool IS TRUE
+LIMIT ?;
+`, int64(10))
+	assertStatementSql(t, base, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > ?;
+`, int64(1))
+}
+
+func TestSelectSeek(t *testing.T) {
+	assertDebugStatementSql(t, SELECT(table1ColInt).FROM(table1).SEEK([]OrderByClause{table1ColInt.ASC(), table1ColFloat.DESC()}, 1, 2.5).LIMIT(5), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (table1.col_int > 1) OR ((table1.col_int = 1) AND (table1.col_float < 2.5))
+ORDER BY table1.col_int ASC, table1.col_float DESC
+LIMIT 5;
+`)
+}
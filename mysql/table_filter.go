@@ -0,0 +1,43 @@
+package mysql
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// TableFilter injects predicate into every statement referencing one of the filtered tables. Predicate is added
+// to the WHERE clause of SELECT, UPDATE and DELETE statements, and to the ON condition for the joined tables.
+// INSERT statements into the filtered tables set the columns of the insert assigments. INSERT statements with
+// ON DUPLICATE KEY UPDATE clause can not be filtered, and return an error on execution.
+type TableFilter = jet.TableFilter
+
+// NewTableFilter creates new table filter for the list of filtered tables. Predicate factory returns the predicate
+// for the filtered table, and optional insert assigments factory returns column assigments for the INSERT statements.
+// Predicate columns of the filtered table, and columns without table name (for instance IntegerColumn("tenant_id")),
+// are qualified with the table alias if the table is aliased in the statement.
+func NewTableFilter(tables []Table, predicate func(table Table) BoolExpression, insertAssigments func(table Table) []ColumnAssigment) *TableFilter {
+	var jetTables []jet.Table
+
+	for _, table := range tables {
+		jetTables = append(jetTables, table)
+	}
+
+	var jetPredicate func(table jet.Table) BoolExpression
+
+	if predicate != nil {
+		jetPredicate = func(table jet.Table) BoolExpression {
+			return predicate(table.(Table))
+		}
+	}
+
+	var jetInsertAssigments func(table jet.Table) []ColumnAssigment
+
+	if insertAssigments != nil {
+		jetInsertAssigments = func(table jet.Table) []ColumnAssigment {
+			return insertAssigments(table.(Table))
+		}
+	}
+
+	return jet.NewTableFilter(jetTables, jetPredicate, jetInsertAssigments)
+}
+
+// ContextWithTableFilters returns a copy of context carrying the list of table filters. Table filters are applied
+// to every statement executed or prepared with the context.
+var ContextWithTableFilters = jet.ContextWithTableFilters
@@ -0,0 +1,45 @@
+package mysql
+
+import "testing"
+
+func TestTableFilter(t *testing.T) {
+	tenantFilter := NewTableFilter(
+		[]Table{table1},
+		func(table Table) BoolExpression {
+			return IntegerColumn("tenant_id").EQ(Int(7))
+		},
+		func(table Table) []ColumnAssigment {
+			return []ColumnAssigment{IntegerColumn("tenant_id").SET(Int(7))}
+		},
+	)
+
+	assertDebugStatementSql(t, SELECT(table1ColInt, table2ColInt).
+		FROM(table2.INNER_JOIN(table1, table1ColInt.EQ(table2ColInt))).
+		WithTableFilters(tenantFilter), `
+SELECT table1.col_int AS "table1.col_int",
+     table2.col_int AS "table2.col_int"
+FROM db.table2
+     INNER JOIN db.table1 ON ((table1.col_int = table2.col_int) AND (table1.tenant_id = 7));
+`)
+
+	assertDebugStatementSql(t, table1.UPDATE(table1ColInt).
+		SET(1).
+		WHERE(table1ColInt.GT(Int(2))).
+		WithTableFilters(tenantFilter), `
+UPDATE db.table1
+SET col_int = 1
+WHERE (table1.col_int > 2) AND (table1.tenant_id = 7);
+`)
+
+	assertDebugStatementSql(t, table1.INSERT(table1ColInt).
+		VALUES(1).
+		WithTableFilters(tenantFilter), `
+INSERT INTO db.table1 (col_int, tenant_id)
+VALUES (1, 7);
+`)
+
+	assertStatementSqlErr(t, table1.INSERT(table1ColInt).
+		VALUES(1).
+		ON_DUPLICATE_KEY_UPDATE(table1ColInt.SET(Int(2))).
+		WithTableFilters(tenantFilter), "jet: table filter can not be applied to ON DUPLICATE KEY UPDATE clause")
+}
@@ -99,3 +99,33 @@ CROSS JOIN db.table2`)
 CROSS JOIN db.table2
 CROSS JOIN db.table3`)
 }
+
+func TestSoftDeleteTable(t *testing.T) {
+	softDeleteColInt := IntegerColumn("col_int")
+	softDeleteColDeletedAt := TimestampColumn("deleted_at")
+	softDeleteTable := NewSoftDeleteTable("db", "soft_table", "", softDeleteColDeletedAt, softDeleteColInt, softDeleteColDeletedAt)
+
+	assertDebugStatementSql(t, SELECT(softDeleteColInt).FROM(softDeleteTable.INNER_JOIN(table1, table1ColInt.EQ(softDeleteColInt))), `
+SELECT soft_table.col_int AS "soft_table.col_int"
+FROM db.soft_table
+     INNER JOIN db.table1 ON (table1.col_int = soft_table.col_int)
+WHERE soft_table.deleted_at IS NULL;
+`)
+
+	deleteStmt := softDeleteTable.DELETE().WHERE(softDeleteColInt.EQ(Int(1))).LIMIT(1)
+
+	assertDebugStatementSql(t, deleteStmt, `
+UPDATE db.soft_table
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE (soft_table.col_int = 1) AND soft_table.deleted_at IS NULL
+LIMIT 1;
+`)
+	assertDebugStatementSql(t, deleteStmt.Unscoped(), `
+DELETE FROM db.soft_table
+WHERE soft_table.col_int = 1
+LIMIT 1;
+`)
+
+	assertStatementSqlErr(t, softDeleteTable.DELETE().USING(table1).WHERE(softDeleteColInt.EQ(table1ColInt)),
+		"jet: USING clause is not supported for soft delete tables, use Unscoped statement")
+}
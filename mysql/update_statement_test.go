@@ -80,3 +80,23 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list for SET clause")
 }
+
+func TestUpdateVersionedModel(t *testing.T) {
+	colID := IntegerColumn("id")
+	colName := StringColumn("name")
+	colVersion := IntegerColumn("version")
+	versioned := NewTableWithOptions("db", "versioned", "", TableOptions{VersionColumn: colVersion}, colID, colName, colVersion)
+
+	model := struct {
+		ID      int64
+		Name    string
+		Version int64
+	}{ID: 1, Name: "name", Version: 3}
+
+	assertDebugStatementSql(t, versioned.UPDATE(colName).MODEL(model).WHERE(colID.EQ(Int(model.ID))), `
+UPDATE db.versioned
+SET name = 'name',
+    version = (versioned.version + 1)
+WHERE (versioned.id = 1) AND (versioned.version = 3);
+`)
+}
@@ -0,0 +1,25 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspect(t *testing.T) {
+	info := Inspect(table1.INSERT(table1ColInt, table1ColFloat).
+		VALUES(1, 2.2).
+		ON_DUPLICATE_KEY_UPDATE(table1ColFloat.SET(Float(3.3))))
+
+	require.Equal(t, InsertStatementType, info.Type)
+	require.True(t, info.Writes)
+	require.Equal(t, []string{"INSERT", "VALUES", "ON DUPLICATE KEY UPDATE"}, info.Clauses)
+	require.Equal(t, []TableReference{{Schema: "db", Name: "table1"}}, info.Tables)
+	require.Equal(t, []ColumnReference{{Table: "table1", Name: "col_int"}, {Table: "table1", Name: "col_float"}}, info.Columns)
+	require.Equal(t, []interface{}{1, 2.2, 3.3}, info.Literals)
+
+	lockInfo := Inspect(table1.LOCK().READ())
+
+	require.Equal(t, LockStatementType, lockInfo.Type)
+	require.False(t, lockInfo.Writes)
+}
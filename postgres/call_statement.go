@@ -0,0 +1,61 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// CallStatement is interface for PostgreSQL CALL statement, invoking stored procedure
+type CallStatement interface {
+	ConfigurableStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() CallStatement
+}
+
+// CALL creates new CallStatement invoking the procedure with the list of arguments. Procedure name can be schema
+// qualified.
+func CALL(procedure string, arguments ...Expression) CallStatement {
+	newCall := &callStatementImpl{}
+	newCall.Call.Procedure = procedure
+	newCall.Call.Arguments = arguments
+
+	return newCall.init()
+}
+
+type callStatementImpl struct {
+	jet.SerializerStatement
+
+	Call clauseCall
+}
+
+func (c *callStatementImpl) init() *callStatementImpl {
+	c.SerializerStatement = jet.NewStatementImpl(Dialect, jet.CallStatementType, c, &c.Call)
+
+	return c
+}
+
+func (c *callStatementImpl) Clone() CallStatement {
+	newCall := *c
+	jet.CloneSlices(&newCall)
+
+	return newCall.init()
+}
+
+// clauseCall is CALL procedure(arguments) clause
+type clauseCall struct {
+	Procedure string
+	Arguments []Expression
+}
+
+func (c *clauseCall) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if c.Procedure == "" {
+		panic("jet: CALL statement procedure is not set")
+	}
+
+	out.NewLine()
+	out.WriteKeyword("CALL")
+	jet.Serialize(Func(c.Procedure, c.Arguments...), statementType, out, jet.NoWrap)
+}
+
+func (c *clauseCall) ClauseName() string {
+	return "CALL"
+}
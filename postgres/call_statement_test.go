@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallStatement(t *testing.T) {
+	assertStatementSql(t, CALL("public.process_orders"), `
+CALL public.process_orders();
+`)
+	assertDebugStatementSql(t, CALL("process_order", Int(11), String("shipped"), table1ColInt.ADD(Int(1))), `
+CALL process_order(11, 'shipped'::text, table1.col_int + 1);
+`, int64(11), "shipped", int64(1))
+}
+
+func TestCallStatementInspect(t *testing.T) {
+	info := jet.Inspect(CALL("process_order", Int(11)))
+
+	require.Equal(t, CallStatementType, info.Type)
+	require.True(t, info.Writes)
+	require.Equal(t, []string{"CALL"}, info.Clauses)
+}
+
+func TestCallStatementNoProcedure(t *testing.T) {
+	assertStatementSqlErr(t, CALL(""), "jet: CALL statement procedure is not set")
+}
+
+func TestTableFunction(t *testing.T) {
+	orderID := IntegerColumn("order_id")
+	total := FloatColumn("total")
+	getOrders := NewTableFunction("public", "get_orders", "", []Expression{Int(10), String("new")}, orderID, total)
+
+	assertDebugStatementSql(t, SELECT(orderID, total).FROM(getOrders).WHERE(total.GT(Float(100))), `
+SELECT get_orders.order_id AS "get_orders.order_id",
+     get_orders.total AS "get_orders.total"
+FROM public.get_orders(10, 'new'::text)
+WHERE get_orders.total > 100;
+`, int64(10), "new", float64(100))
+
+	lastOrder := IntegerColumn("order_id")
+	aliased := NewTableFunction("public", "get_orders", "o", getOrders.Arguments(), lastOrder)
+
+	assertStatementSql(t, table1.INNER_JOIN(aliased, lastOrder.EQ(table1ColInt)).SELECT(lastOrder), `
+SELECT o.order_id AS "o.order_id"
+FROM db.table1
+     INNER JOIN public.get_orders($1, $2::text) AS o ON (o.order_id = table1.col_int);
+`, int64(10), "new")
+}
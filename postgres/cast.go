@@ -18,7 +18,7 @@ type cast interface {
 	// Cast expression AS bigint type
 	AS_BIGINT() IntegerExpression
 	// Cast expression AS numeric type, using precision and optionally scale
-	AS_NUMERIC(precisionAndScale ...int) FloatExpression
+	AS_NUMERIC(precisionAndScale ...int) DecimalExpression
 	// Cast expression AS real type
 	AS_REAL() FloatExpression
 	// Cast expression AS double precision type
@@ -28,7 +28,7 @@ type cast interface {
 	// Cast expression AS date type
 	AS_DATE() DateExpression
 	// Cast expression AS numeric type, using precision and optionally scale
-	AS_DECIMAL() FloatExpression
+	AS_DECIMAL() DecimalExpression
 	// Cast expression AS time type
 	AS_TIME() TimeExpression
 	// Cast expression AS text type
@@ -84,7 +84,7 @@ func (b *castImpl) AS_BIGINT() IntegerExpression {
 }
 
 // Cast expression AS numeric type, using precision and optionally scale
-func (b *castImpl) AS_NUMERIC(precisionAndScale ...int) FloatExpression {
+func (b *castImpl) AS_NUMERIC(precisionAndScale ...int) DecimalExpression {
 	var castArgs string
 
 	var argLen = len(precisionAndScale)
@@ -94,7 +94,7 @@ func (b *castImpl) AS_NUMERIC(precisionAndScale ...int) FloatExpression {
 		castArgs = fmt.Sprintf("(%d)", precisionAndScale[0])
 	}
 
-	return FloatExp(b.AS("numeric" + castArgs))
+	return DecimalExp(b.AS("numeric" + castArgs))
 }
 
 // Cast expression AS real type
@@ -126,8 +126,8 @@ func (b *castImpl) AS_DATE() DateExpression {
 }
 
 // Cast expression AS date type
-func (b *castImpl) AS_DECIMAL() FloatExpression {
-	return FloatExp(b.AS("decimal"))
+func (b *castImpl) AS_DECIMAL() DecimalExpression {
+	return DecimalExp(b.AS("decimal"))
 }
 
 // Cast expression AS text type
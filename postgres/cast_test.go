@@ -2,6 +2,8 @@ package postgres
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestExpressionCAST_AS(t *testing.T) {
@@ -29,6 +31,8 @@ func TestExpressionCAST_AS_BIGINT(t *testing.T) {
 func TestExpressionCAST_AS_NUMERIC(t *testing.T) {
 	assertSerialize(t, CAST(table2Col3).AS_NUMERIC(11, 11), "table2.col3::numeric(11, 11)")
 	assertSerialize(t, CAST(table2Col3).AS_NUMERIC(11), "table2.col3::numeric(11)")
+	assertSerialize(t, CAST(table2Col3).AS_NUMERIC(11, 2).MUL(Decimal("1.25")), "(table2.col3::numeric(11, 2) * $1)", "1.25")
+	require.Implements(t, (*DecimalExpression)(nil), CAST(table2Col3).AS_NUMERIC().ADD(Int(1)))
 }
 
 func TestExpressionCAST_AS_REAL(t *testing.T) {
@@ -44,13 +44,17 @@ func (o *onConflictClause) DO_UPDATE(action conflictAction) InsertStatement {
 	return o.insertStatement
 }
 
+func (o *onConflictClause) ClauseName() string {
+	return "ON CONFLICT"
+}
+
 func (o *onConflictClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 	if is.Nil(o.do) {
 		return
 	}
 
 	out.NewLine()
-	out.WriteString("ON CONFLICT")
+	out.WriteKeyword("ON CONFLICT")
 	if len(o.indexExpressions) > 0 {
 		out.WriteString("(")
 		jet.SerializeColumnExpressions(o.indexExpressions, statementType, out, jet.ShortName)
@@ -58,7 +62,7 @@ func (o *onConflictClause) Serialize(statementType jet.StatementType, out *jet.S
 	}
 
 	if o.constraint != "" {
-		out.WriteString("ON CONSTRAINT")
+		out.WriteKeyword("ON CONSTRAINT")
 		out.WriteString(o.constraint)
 	}
 
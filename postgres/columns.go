@@ -35,6 +35,12 @@ type ColumnFloat = jet.ColumnFloat
 // FloatColumn creates named float column.
 var FloatColumn = jet.FloatColumn
 
+// ColumnDecimal is interface for SQL numeric or decimal column.
+type ColumnDecimal = jet.ColumnDecimal
+
+// DecimalColumn creates named decimal column.
+var DecimalColumn = jet.DecimalColumn
+
 // ColumnDate is interface of SQL date columns.
 type ColumnDate = jet.ColumnDate
 
@@ -13,6 +13,7 @@ func SET(assigments ...ColumnAssigment) conflictAction {
 	conflictAction.doUpdate = jet.KeywordClause{Keyword: "DO UPDATE"}
 	conflictAction.Serializer = jet.NewSerializerClauseImpl(&conflictAction.doUpdate, &conflictAction.set, &conflictAction.where)
 	conflictAction.set = assigments
+	conflictAction.where.ConflictAction = true
 	return &conflictAction
 }
 
@@ -9,6 +9,10 @@ type DeleteStatement interface {
 	USING(tables ...ReadableTable) DeleteStatement
 	WHERE(expression BoolExpression) DeleteStatement
 	RETURNING(projections ...jet.Projection) DeleteStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() DeleteStatement
 }
 
 type deleteStatementImpl struct {
@@ -22,17 +26,29 @@ type deleteStatementImpl struct {
 
 func newDeleteStatement(table WritableTable) DeleteStatement {
 	newDelete := &deleteStatementImpl{}
-	newDelete.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, newDelete,
-		&newDelete.Delete,
-		&newDelete.Using,
-		&newDelete.Where,
-		&newDelete.Returning)
-
 	newDelete.Delete.Table = table
 	newDelete.Using.Name = "USING"
+	newDelete.Using.SoftDeleteName = "FROM"
 	newDelete.Where.Mandatory = true
 
-	return newDelete
+	return newDelete.init()
+}
+
+func (d *deleteStatementImpl) init() *deleteStatementImpl {
+	d.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, d,
+		&d.Delete,
+		&d.Using,
+		&d.Where,
+		&d.Returning)
+
+	return d
+}
+
+func (d *deleteStatementImpl) Clone() DeleteStatement {
+	newDelete := *d
+	jet.CloneSlices(&newDelete)
+
+	return newDelete.init()
 }
 
 func (d *deleteStatementImpl) USING(tables ...ReadableTable) DeleteStatement {
@@ -23,3 +23,18 @@ WHERE table1.col1 = $1
 RETURNING table1.col1 AS "table1.col1";
 `, int64(1))
 }
+
+func TestDeleteClone(t *testing.T) {
+	base := table1.DELETE().WHERE(table1ColInt.EQ(Int(1)))
+
+	assertDebugStatementSql(t, base.Clone().USING(table2).RETURNING(table1ColInt), `
+DELETE FROM db.table1
+USING db.table2
+WHERE table1.col_int = 1
+RETURNING table1.col_int AS "table1.col_int";
+`)
+	assertDebugStatementSql(t, base, `
+DELETE FROM db.table1
+WHERE table1.col_int = 1;
+`)
+}
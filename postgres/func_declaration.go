@@ -0,0 +1,46 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// DeclareFunc0 declares database function without parameters, returning expression of type R.
+// For instance:
+//
+//	var GEN_RANDOM_UUID = postgres.DeclareFunc0[postgres.StringExpression]("gen_random_uuid")
+func DeclareFunc0[R Expression](name string) func() R {
+	return jet.DeclareFunc0[R](name)
+}
+
+// DeclareFunc1 declares database function with one parameter, returning expression of type R.
+func DeclareFunc1[A1, R Expression](name string) func(A1) R {
+	return jet.DeclareFunc1[A1, R](name)
+}
+
+// DeclareFunc2 declares database function with two parameters, returning expression of type R.
+// For instance:
+//
+//	var SIMILARITY = postgres.DeclareFunc2[postgres.StringExpression, postgres.StringExpression, postgres.FloatExpression]("similarity")
+func DeclareFunc2[A1, A2, R Expression](name string) func(A1, A2) R {
+	return jet.DeclareFunc2[A1, A2, R](name)
+}
+
+// DeclareFunc3 declares database function with three parameters, returning expression of type R.
+func DeclareFunc3[A1, A2, A3, R Expression](name string) func(A1, A2, A3) R {
+	return jet.DeclareFunc3[A1, A2, A3, R](name)
+}
+
+// DeclareFunc4 declares database function with four parameters, returning expression of type R.
+func DeclareFunc4[A1, A2, A3, A4, R Expression](name string) func(A1, A2, A3, A4) R {
+	return jet.DeclareFunc4[A1, A2, A3, A4, R](name)
+}
+
+// DeclareFuncN declares variadic database function with parameters of the same type, returning expression of type R.
+func DeclareFuncN[A, R Expression](name string) func(...A) R {
+	return jet.DeclareFuncN[A, R](name)
+}
+
+// FIELD returns composite type field expression of type T: (composite).field. For instance:
+//
+//	FIELD[StringExpression](User.HomeAddress, "city").EQ(String("Paris"))
+func FIELD[T Expression](composite Expression, field string) T {
+	return jet.TypedExp[T](jet.CompositeField(composite, field))
+}
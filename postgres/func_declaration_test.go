@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"testing"
+)
+
+var (
+	similarity    = DeclareFunc2[StringExpression, StringExpression, FloatExpression]("similarity")
+	justifyDays   = DeclareFunc1[IntervalExpression, IntervalExpression]("justify_days")
+	genRandomUUID = DeclareFunc0[StringExpression]("gen_random_uuid")
+)
+
+func TestDeclareFunc(t *testing.T) {
+	assertSerialize(t, similarity(table2ColStr, String("word")).GT(Float(0.3)),
+		"(similarity(table2.col_str, $1::text) > $2)", "word", 0.3)
+	assertSerialize(t, justifyDays(INTERVAL(35, DAY)).GT(INTERVAL(1, MONTH)),
+		"(justify_days(INTERVAL '35 DAY') > INTERVAL '1 MONTH')")
+	assertSerialize(t, genRandomUUID().EQ(table2ColStr), "(gen_random_uuid() = table2.col_str)")
+	assertSerialize(t, DeclareFuncN[IntegerExpression, IntegerExpression]("greatest")(table1ColInt, Int(2)),
+		"greatest(table1.col_int, $1)", int64(2))
+}
+
+func TestFIELD(t *testing.T) {
+	assertSerialize(t, FIELD[StringExpression](table2ColStr, "city").EQ(String("Paris")),
+		"((table2.col_str).city = $1::text)", "Paris")
+	assertSerialize(t, FIELD[IntegerExpression](FIELD[Expression](table1ColInt, "Address"), "user").ADD(Int(1)),
+		`(((table1.col_int)."Address")."user" + $1)`, int64(1))
+	assertSerialize(t, FIELD[IntervalExpression](ROW(Int(1), INTERVAL(1, DAY)), "f2"),
+		"(ROW($1, INTERVAL '1 DAY')).f2", int64(1))
+}
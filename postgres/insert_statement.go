@@ -17,21 +17,40 @@ type InsertStatement interface {
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
 
 	RETURNING(projections ...Projection) InsertStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() InsertStatement
 }
 
 func newInsertStatement(table WritableTable, columns []jet.Column) InsertStatement {
 	newInsert := &insertStatementImpl{}
-	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
-		&newInsert.Insert,
-		&newInsert.ValuesQuery,
-		&newInsert.OnConflict,
-		&newInsert.Returning,
-	)
-
 	newInsert.Insert.Table = table
 	newInsert.Insert.Columns = columns
 
-	return newInsert
+	return newInsert.init()
+}
+
+func (i *insertStatementImpl) init() *insertStatementImpl {
+	i.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, i,
+		&i.Insert,
+		&i.ValuesQuery,
+		&i.OnConflict,
+		&i.Returning,
+	)
+
+	if i.OnConflict.insertStatement != nil {
+		i.OnConflict.insertStatement = i
+	}
+
+	return i
+}
+
+func (i *insertStatementImpl) Clone() InsertStatement {
+	newInsert := *i
+	jet.CloneSlices(&newInsert)
+
+	return newInsert.init()
 }
 
 type insertStatementImpl struct {
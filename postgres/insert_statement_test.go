@@ -1,8 +1,15 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
 	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 	"testing"
 	"time"
 )
@@ -90,6 +97,106 @@ VALUES ($1, $2),
 	assertStatementSql(t, stmt, expectedSQL, 1, float64(1.11), 1, float64(1.11))
 }
 
+func TestInsertValuesFromModelWithNullableTypes(t *testing.T) {
+	type Table1Model struct {
+		Col1     qrm.Null[int32]
+		ColFloat sql.NullFloat64
+		ColBool  null.Bool
+	}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat, table1ColBool).
+		MODEL(Table1Model{}).
+		MODEL(Table1Model{Col1: qrm.NewNull(int32(1)), ColFloat: sql.NullFloat64{Float64: 1.11, Valid: true}, ColBool: null.BoolFrom(true)})
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float, col_bool)
+VALUES ($1, $2, $3),
+       ($4, $5, $6);
+`, nil, nil, nil, qrm.NewNull(int32(1)), sql.NullFloat64{Float64: 1.11, Valid: true}, null.BoolFrom(true))
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float, col_bool)
+VALUES (NULL, NULL, NULL),
+       (1, 1.11, TRUE);
+`)
+}
+
+func TestInsertValuesFromModelWithDecimalTypes(t *testing.T) {
+	type Table1Model struct {
+		Col1     qrm.Null[qrm.Decimal]
+		ColFloat decimal.Decimal
+	}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		MODEL(Table1Model{
+			Col1:     qrm.NewNull(qrm.MustDecimal("12345678901234567890.123456789")),
+			ColFloat: decimal.RequireFromString("0.30000000000000000001"),
+		})
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES ('12345678901234567890.123456789', '0.30000000000000000001');
+`)
+}
+
+func TestInsertValuesFromModelWithRegisteredTypeConverter(t *testing.T) {
+	type money struct {
+		cents int64
+	}
+
+	qrm.RegisterTypeConverter(nil, func(value money) (driver.Value, error) {
+		return fmt.Sprintf("%d.%02d", value.cents/100, value.cents%100), nil
+	})
+	defer qrm.UnregisterTypeConverter[money]()
+
+	type Table1Model struct {
+		Col1     int
+		ColFloat money
+	}
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat).
+		MODEL(Table1Model{Col1: 1, ColFloat: money{cents: 1011}}).
+		VALUES(2, money{cents: 200})
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES ($1, $2),
+       ($3, $4);
+`, 1, "10.11", 2, "2.00")
+
+	assertDebugStatementSql(t, stmt, `
+INSERT INTO db.table1 (col1, col_float)
+VALUES (1, '10.11'),
+       (2, '2.00');
+`)
+}
+
+func TestInsertRegisteredTypeConverterError(t *testing.T) {
+	type money struct {
+		cents int64
+	}
+
+	qrm.RegisterTypeConverter(nil, func(value money) (driver.Value, error) {
+		return nil, fmt.Errorf("negative amount")
+	})
+	defer qrm.UnregisterTypeConverter[money]()
+
+	stmt := table1.INSERT(table1Col1, table1ColFloat).VALUES(1, money{cents: -1})
+
+	_, err := stmt.Exec(unusedExecutable{})
+	require.EqualError(t, err, "jet: failed to convert postgres.money argument to driver value: negative amount")
+
+	require.PanicsWithValue(t, "jet: failed to convert postgres.money argument to driver value: negative amount", func() {
+		stmt.Sql()
+	})
+}
+
+type unusedExecutable struct{}
+
+func (unusedExecutable) ExecContext(context.Context, string, ...interface{}) (sql.Result, error) {
+	return nil, fmt.Errorf("not expected")
+}
+
 func TestInsertValuesFromModelColumnMismatch(t *testing.T) {
 	defer func() {
 		r := recover()
@@ -199,3 +306,31 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsertClone(t *testing.T) {
+	base := table1.INSERT(table1Col1, table1ColBool).
+		VALUES("one", "two")
+
+	withConflict := base.Clone().VALUES("1", "2")
+	withConflict.ON_CONFLICT(table1Col1).DO_NOTHING()
+	withReturning := base.Clone().VALUES("3", "4").RETURNING(table1Col1)
+
+	assertDebugStatementSql(t, base, `
+INSERT INTO db.table1 (col1, col_bool)
+VALUES ('one', 'two');
+`)
+
+	assertDebugStatementSql(t, withConflict.Clone(), `
+INSERT INTO db.table1 (col1, col_bool)
+VALUES ('one', 'two'),
+       ('1', '2')
+ON CONFLICT (col1) DO NOTHING;
+`)
+
+	assertDebugStatementSql(t, withReturning, `
+INSERT INTO db.table1 (col1, col_bool)
+VALUES ('one', 'two'),
+       ('3', '4')
+RETURNING table1.col1 AS "table1.col1";
+`)
+}
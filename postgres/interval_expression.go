@@ -249,6 +249,10 @@ func newIntervalExpressionWrap(expression Expression) IntervalExpression {
 	return intervalWrap
 }
 
+func init() {
+	jet.RegisterTypedExp(IntervalExp)
+}
+
 // IntervalExp is interval expression wrapper around arbitrary expression.
 // Allows go compiler to see any expression as interval expression.
 // Does not add sql cast to generated sql builder output.
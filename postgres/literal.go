@@ -57,7 +57,7 @@ func Uint64(value uint64) IntegerExpression {
 // Float creates new float literal expression
 var Float = jet.Float
 
-// Decimal creates new float literal expression
+// Decimal creates new decimal literal expression from string value
 var Decimal = jet.Decimal
 
 // String creates new string literal expression
@@ -138,3 +138,77 @@ func Timestampz(year int, month time.Month, day, hour, minute, second int, milli
 func TimestampzT(t time.Time) TimestampzExpression {
 	return CAST(jet.TimestampzT(t)).AS_TIMESTAMPZ()
 }
+
+// Param creates new named parameter expression of the type T, which value has to be set at statement execution time,
+// using statement WithArgs method or prepared statement named arguments. T has to be one of the expression interfaces,
+// and parameter can be used wherever expression of the type T is expected, for instance Param[IntegerExpression]("limit").
+// Named parameters are serialized the same way as the literals of the same type.
+func Param[T Expression](name string) T {
+	var param Expression
+
+	switch any((*T)(nil)).(type) {
+	case *BoolExpression:
+		param = CAST(jet.BoolParam(name)).AS_BOOL()
+	case *StringExpression:
+		param = CAST(jet.StringParam(name)).AS_TEXT()
+	case *DateExpression:
+		param = CAST(jet.DateParam(name)).AS_DATE()
+	case *TimeExpression:
+		param = CAST(jet.TimeParam(name)).AS_TIME()
+	case *TimezExpression:
+		param = CAST(jet.TimezParam(name)).AS_TIMEZ()
+	case *TimestampExpression:
+		param = CAST(jet.TimestampParam(name)).AS_TIMESTAMP()
+	case *TimestampzExpression:
+		param = CAST(jet.TimestampzParam(name)).AS_TIMESTAMPZ()
+	default:
+		return jet.Param[T](name)
+	}
+
+	return param.(T)
+}
+
+// BoolParam creates new named parameter bool expression
+func BoolParam(name string) BoolExpression {
+	return Param[BoolExpression](name)
+}
+
+// IntParam creates new named parameter integer expression
+func IntParam(name string) IntegerExpression {
+	return Param[IntegerExpression](name)
+}
+
+// FloatParam creates new named parameter float expression
+func FloatParam(name string) FloatExpression {
+	return Param[FloatExpression](name)
+}
+
+// StringParam creates new named parameter string expression
+func StringParam(name string) StringExpression {
+	return Param[StringExpression](name)
+}
+
+// DateParam creates new named parameter date expression
+func DateParam(name string) DateExpression {
+	return Param[DateExpression](name)
+}
+
+// TimeParam creates new named parameter time expression
+func TimeParam(name string) TimeExpression {
+	return Param[TimeExpression](name)
+}
+
+// TimezParam creates new named parameter time with time zone expression
+func TimezParam(name string) TimezExpression {
+	return Param[TimezExpression](name)
+}
+
+// TimestampParam creates new named parameter timestamp expression
+func TimestampParam(name string) TimestampExpression {
+	return Param[TimestampExpression](name)
+}
+
+// TimestampzParam creates new named parameter timestamp with time zone expression
+func TimestampzParam(name string) TimestampzExpression {
+	return Param[TimestampzExpression](name)
+}
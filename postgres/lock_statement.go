@@ -19,22 +19,38 @@ const (
 
 // LockStatement is interface for MySQL LOCK tables
 type LockStatement interface {
-	Statement
+	ConfigurableStatement
 
 	IN(lockMode TableLockMode) LockStatement
 	NOWAIT() LockStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() LockStatement
 }
 
 // LOCK creates LockStatement from list of tables
 func LOCK(tables ...jet.SerializerTable) LockStatement {
 	newLock := &lockStatementImpl{}
-	newLock.SerializerStatement = jet.NewStatementImpl(Dialect, jet.LockStatementType, newLock,
-		&newLock.StatementBegin, &newLock.In, &newLock.NoWait)
-
 	newLock.StatementBegin.Name = "LOCK TABLE"
 	newLock.StatementBegin.Tables = tables
 	newLock.NoWait.Name = "NOWAIT"
-	return newLock
+
+	return newLock.init()
+}
+
+func (l *lockStatementImpl) init() *lockStatementImpl {
+	l.SerializerStatement = jet.NewStatementImpl(Dialect, jet.LockStatementType, l,
+		&l.StatementBegin, &l.In, &l.NoWait)
+
+	return l
+}
+
+func (l *lockStatementImpl) Clone() LockStatement {
+	newLock := *l
+	jet.CloneSlices(&newLock)
+
+	return newLock.init()
 }
 
 type lockStatementImpl struct {
@@ -30,3 +30,14 @@ LOCK TABLE db.table1 IN EXCLUSIVE MODE;
 LOCK TABLE db.table1 IN ACCESS EXCLUSIVE MODE NOWAIT;
 `)
 }
+
+func TestLockTableClone(t *testing.T) {
+	base := table1.LOCK().IN(LOCK_ACCESS_SHARE)
+
+	assertStatementSql(t, base.Clone().NOWAIT(), `
+LOCK TABLE db.table1 IN ACCESS SHARE MODE NOWAIT;
+`)
+	assertStatementSql(t, base, `
+LOCK TABLE db.table1 IN ACCESS SHARE MODE;
+`)
+}
@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamedParameters(t *testing.T) {
+	stmt := SELECT(table2ColInt, table2ColStr).
+		FROM(table2).
+		WHERE(
+			table2ColInt.GT(IntParam("min")).
+				AND(table2ColStr.LIKE(StringParam("pattern"))).
+				AND(table2ColBool.EQ(BoolParam("flag"))),
+		).
+		LIMIT_e(Param[IntegerExpression]("limit")).
+		OFFSET_e(Param[IntegerExpression]("offset"))
+
+	assertStatementSql(t, stmt.WithArgs(map[string]interface{}{"min": 10, "pattern": "%a%", "flag": true, "limit": int64(10), "offset": int64(20)}), `
+SELECT table2.col_int AS "table2.col_int",
+     table2.col_str AS "table2.col_str"
+FROM db.table2
+WHERE ((table2.col_int > $1) AND (table2.col_str LIKE $2::text)) AND (table2.col_bool = $3::boolean)
+LIMIT $4
+OFFSET $5;
+`, 10, "%a%", true, int64(10), int64(20))
+
+	assertDebugStatementSql(t, stmt.WithArgs(map[string]interface{}{"min": 1, "pattern": "b%", "limit": 5}).WithArgs(map[string]interface{}{"flag": false, "offset": nil}), `
+SELECT table2.col_int AS "table2.col_int",
+     table2.col_str AS "table2.col_str"
+FROM db.table2
+WHERE ((table2.col_int > 1) AND (table2.col_str LIKE 'b%'::text)) AND (table2.col_bool = FALSE::boolean)
+LIMIT 5
+OFFSET NULL;
+`)
+}
+
+func TestNamedParametersMisuse(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.EQ(Param[IntegerExpression]("id")))
+
+	require.PanicsWithValue(t, "jet: named parameter 'id' value is not set", func() {
+		stmt.Sql()
+	})
+
+	require.PanicsWithValue(t, "jet: named argument 'ids' does not appear in statement", func() {
+		stmt.WithArgs(map[string]interface{}{"id": int64(1), "ids": int64(2)}).Sql()
+	})
+
+	require.PanicsWithValue(t, "jet: named parameter 'id' value has to be of integer type, got string", func() {
+		stmt.WithArgs(map[string]interface{}{"id": "1"}).Sql()
+	})
+}
+
+func TestNamedParametersRawArgsOverride(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(RawBool("table1.col_int = #id", RawArgs{"#id": 1}).OR(table1ColFloat.EQ(FloatParam("id"))))
+
+	assertStatementSql(t, stmt.WithArgs(map[string]interface{}{"#id": 2, "id": 3.3}), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (table1.col_int = $1) OR (table1.col_float = $2);
+`, 2, 3.3)
+}
+
+func TestNamedParametersTyped(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(
+			table1ColFloat.GT(Param[FloatExpression]("min")).
+				AND(table1ColTimestamp.LT(Param[TimestampExpression]("before"))),
+		).
+		UNION(SELECT(table2ColInt).FROM(table2)).
+		LIMIT_e(Param[IntegerExpression]("limit"))
+
+	assertStatementSql(t, stmt.WithArgs(map[string]interface{}{"min": "1.5", "before": "2024-01-01", "limit": uint8(3)}), `
+(
+     SELECT table1.col_int AS "table1.col_int"
+     FROM db.table1
+     WHERE (table1.col_float > $1) AND (table1.col_timestamp < $2::timestamp without time zone)
+)
+UNION
+(
+     SELECT table2.col_int AS "table2.col_int"
+     FROM db.table2
+)
+LIMIT $3;
+`, "1.5", "2024-01-01", uint8(3))
+
+	require.PanicsWithValue(t, "jet: named parameter 'limit' value has to be of integer type, got float64", func() {
+		stmt.WithArgs(map[string]interface{}{"min": 1, "before": time.Now(), "limit": 3.5}).Sql()
+	})
+
+	require.PanicsWithValue(t, "jet: named parameter 'before' value has to be of date/time type, got bool", func() {
+		stmt.WithArgs(map[string]interface{}{"min": 1, "before": true, "limit": 1}).Sql()
+	})
+}
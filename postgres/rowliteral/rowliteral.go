@@ -0,0 +1,314 @@
+// Package rowliteral converts PostgreSQL composite type values, in the row literal format (field1,"field 2",), into
+// Go struct fields and back. It is used by the generated composite type model types.
+package rowliteral
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Scan parses composite type row literal value and assigns the row fields to the destinations, in the same order.
+// Destination can be a pointer to a string, bool, integer, float, time.Time, []byte or sql.Scanner, or a pointer to the
+// pointer of those types for nullable fields. NULL value leaves destinations unchanged.
+func Scan(value interface{}, dest ...interface{}) error {
+	var literal string
+
+	switch val := value.(type) {
+	case nil:
+		return nil
+	case string:
+		literal = val
+	case []byte:
+		literal = string(val)
+	default:
+		return fmt.Errorf("jet: invalid composite type value of type %T, value has to be of type string or []byte", value)
+	}
+
+	fields, err := Parse(literal)
+	if err != nil {
+		return err
+	}
+
+	if len(fields) != len(dest) {
+		return fmt.Errorf("jet: composite type value has %d fields, expected %d", len(fields), len(dest))
+	}
+
+	for i, field := range fields {
+		if err := assign(dest[i], field); err != nil {
+			return fmt.Errorf("jet: failed to scan composite type field %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
+
+// Value formats the fields as composite type row literal. Nil fields, or nil pointer fields, are formatted as NULL.
+func Value(fields ...interface{}) (driver.Value, error) {
+	var texts []*string
+
+	for _, field := range fields {
+		text, err := format(field)
+		if err != nil {
+			return nil, err
+		}
+
+		texts = append(texts, text)
+	}
+
+	return Format(texts), nil
+}
+
+// Parse splits row literal into the field texts. NULL fields are returned as nil.
+func Parse(literal string) ([]*string, error) {
+	literal = strings.TrimSpace(literal)
+
+	if len(literal) < 2 || literal[0] != '(' || literal[len(literal)-1] != ')' {
+		return nil, fmt.Errorf("jet: invalid composite type row literal '%s'", literal)
+	}
+
+	var fields []*string
+	var current strings.Builder
+	quoted, hasValue := false, false
+
+	content := literal[1 : len(literal)-1]
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		switch {
+		case quoted && c == '"' && i+1 < len(content) && content[i+1] == '"':
+			current.WriteByte('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+			hasValue = true
+		case c == '\\' && i+1 < len(content):
+			current.WriteByte(content[i+1])
+			hasValue = true
+			i++
+		case c == ',' && !quoted:
+			fields = append(fields, fieldValue(current.String(), hasValue))
+			current.Reset()
+			hasValue = false
+		default:
+			current.WriteByte(c)
+			hasValue = true
+		}
+	}
+
+	if quoted {
+		return nil, fmt.Errorf("jet: invalid composite type row literal '%s', unterminated quote", literal)
+	}
+
+	return append(fields, fieldValue(current.String(), hasValue)), nil
+}
+
+// Format creates row literal from the field texts. Nil fields are formatted as NULL.
+func Format(fields []*string) string {
+	var ret strings.Builder
+
+	ret.WriteByte('(')
+
+	for i, field := range fields {
+		if i > 0 {
+			ret.WriteByte(',')
+		}
+
+		if field == nil {
+			continue
+		}
+
+		if *field != "" && !strings.ContainsAny(*field, "(),\"\\ \t\n\r") {
+			ret.WriteString(*field)
+			continue
+		}
+
+		ret.WriteByte('"')
+		ret.WriteString(strings.NewReplacer(`"`, `""`, `\`, `\\`).Replace(*field))
+		ret.WriteByte('"')
+	}
+
+	ret.WriteByte(')')
+
+	return ret.String()
+}
+
+func fieldValue(text string, hasValue bool) *string {
+	if !hasValue {
+		return nil
+	}
+
+	return &text
+}
+
+func assign(dest interface{}, field *string) error {
+	if scanner, ok := dest.(sql.Scanner); ok {
+		if field == nil {
+			return scanner.Scan(nil)
+		}
+
+		return scanner.Scan(*field)
+	}
+
+	destValue := reflect.ValueOf(dest)
+
+	if destValue.Kind() != reflect.Ptr || destValue.IsNil() {
+		return errors.New("destination has to be non-nil pointer")
+	}
+
+	return assignValue(destValue.Elem(), field)
+}
+
+func assignValue(dest reflect.Value, field *string) error {
+	if dest.Kind() == reflect.Ptr {
+		if field == nil {
+			dest.Set(reflect.Zero(dest.Type()))
+			return nil
+		}
+
+		newValue := reflect.New(dest.Type().Elem())
+
+		if err := assign(newValue.Interface(), field); err != nil {
+			return err
+		}
+
+		dest.Set(newValue)
+		return nil
+	}
+
+	if field == nil {
+		dest.Set(reflect.Zero(dest.Type()))
+		return nil
+	}
+
+	text := *field
+
+	switch dest.Interface().(type) {
+	case time.Time:
+		t, err := parseTime(text)
+		if err != nil {
+			return err
+		}
+		dest.Set(reflect.ValueOf(t))
+		return nil
+	case []byte:
+		data, err := hex.DecodeString(strings.TrimPrefix(text, `\x`))
+		if err != nil {
+			return err
+		}
+		dest.SetBytes(data)
+		return nil
+	}
+
+	switch dest.Kind() {
+	case reflect.String:
+		dest.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		dest.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, dest.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dest.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, dest.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dest.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, dest.Type().Bits())
+		if err != nil {
+			return err
+		}
+		dest.SetFloat(f)
+	default:
+		return fmt.Errorf("unsupported destination type %s", dest.Type())
+	}
+
+	return nil
+}
+
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+	"15:04:05.999999999Z07:00",
+	"15:04:05.999999999Z07",
+	"15:04:05.999999999",
+}
+
+func parseTime(text string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, text); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time value '%s'", text)
+}
+
+func format(field interface{}) (*string, error) {
+	if valuer, ok := field.(driver.Valuer); ok {
+		if value := reflect.ValueOf(field); value.Kind() == reflect.Ptr && value.IsNil() {
+			return nil, nil
+		}
+
+		value, err := valuer.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		field = value
+	}
+
+	value := reflect.ValueOf(field)
+
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return nil, nil
+		}
+
+		value = value.Elem()
+	}
+
+	if !value.IsValid() {
+		return nil, nil
+	}
+
+	var text string
+
+	switch val := value.Interface().(type) {
+	case time.Time:
+		text = val.Format("2006-01-02 15:04:05.999999999Z07:00")
+	case []byte:
+		text = `\x` + hex.EncodeToString(val)
+	case driver.Valuer:
+		return format(val)
+	default:
+		switch value.Kind() {
+		case reflect.Bool:
+			text = "f"
+			if value.Bool() {
+				text = "t"
+			}
+		default:
+			text = fmt.Sprint(value.Interface())
+		}
+	}
+
+	return &text, nil
+}
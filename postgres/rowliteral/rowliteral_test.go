@@ -0,0 +1,94 @@
+package rowliteral
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestParse(t *testing.T) {
+	fields, err := Parse(`(1,"Main St, 5",,"","say ""hi""","back\\slash")`)
+	require.NoError(t, err)
+	require.Equal(t, []*string{strPtr("1"), strPtr("Main St, 5"), nil, strPtr(""), strPtr(`say "hi"`), strPtr(`back\slash`)}, fields)
+
+	fields, err = Parse("()")
+	require.NoError(t, err)
+	require.Equal(t, []*string{nil}, fields)
+
+	_, err = Parse("1,2")
+	require.EqualError(t, err, "jet: invalid composite type row literal '1,2'")
+
+	_, err = Parse(`("abc)`)
+	require.EqualError(t, err, `jet: invalid composite type row literal '("abc)', unterminated quote`)
+}
+
+func TestFormat(t *testing.T) {
+	require.Equal(t, `(1,"Main St, 5",,"","say ""hi""","back\\slash")`,
+		Format([]*string{strPtr("1"), strPtr("Main St, 5"), nil, strPtr(""), strPtr(`say "hi"`), strPtr(`back\slash`)}))
+}
+
+type email string
+
+type address struct {
+	Street *string
+	Number int32
+	Email  *email
+}
+
+func (a *address) Scan(value interface{}) error {
+	return Scan(value, &a.Street, &a.Number, &a.Email)
+}
+
+func TestScan(t *testing.T) {
+	var (
+		name     string
+		amount   float64
+		active   bool
+		created  time.Time
+		data     []byte
+		id       uuid.UUID
+		home     *address
+		optional *int64
+	)
+
+	err := Scan([]byte(`(John,12.5,t,"2024-01-02 10:11:12+01",\\x0102,a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,"(""Main St"",5,)",)`),
+		&name, &amount, &active, &created, &data, &id, &home, &optional)
+	require.NoError(t, err)
+
+	require.Equal(t, "John", name)
+	require.Equal(t, 12.5, amount)
+	require.True(t, active)
+	require.True(t, created.Equal(time.Date(2024, 1, 2, 9, 11, 12, 0, time.UTC)))
+	require.Equal(t, []byte{1, 2}, data)
+	require.Equal(t, "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", id.String())
+	require.Equal(t, &address{Street: strPtr("Main St"), Number: 5}, home)
+	require.Nil(t, optional)
+}
+
+func TestScanErrors(t *testing.T) {
+	var name string
+
+	require.NoError(t, Scan(nil, &name))
+	require.EqualError(t, Scan(11, &name), "jet: invalid composite type value of type int, value has to be of type string or []byte")
+	require.EqualError(t, Scan("(a,b)", &name), "jet: composite type value has 2 fields, expected 1")
+
+	var number int16
+	require.EqualError(t, Scan("(abc)", &number),
+		`jet: failed to scan composite type field 1: strconv.ParseInt: parsing "abc": invalid syntax`)
+}
+
+func TestValue(t *testing.T) {
+	var nilString *string
+	e := email("john@example.com")
+
+	value, err := Value(strPtr("Main St"), int32(5), nilString, &e, true, 1.5,
+		time.Date(2024, 1, 2, 10, 11, 12, 0, time.UTC), []byte{1, 2}, uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), nil)
+	require.NoError(t, err)
+	require.Equal(t, `("Main St",5,,john@example.com,t,1.5,"2024-01-02 10:11:12Z","\\x0102",a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11,)`, value)
+}
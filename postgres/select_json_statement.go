@@ -0,0 +1,130 @@
+package postgres
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// SelectJsonStatement is interface for PostgreSQL SELECT statement, which result set rows are aggregated
+// into a single JSON column on the database side. SelectJsonStatement can be nested, with an alias,
+// into the projection list of another SelectJsonStatement.
+type SelectJsonStatement interface {
+	ConfigurableStatement
+	jet.HasProjections
+	Expression
+
+	FROM(tables ...ReadableTable) SelectJsonStatement
+	WHERE(expression BoolExpression) SelectJsonStatement
+	GROUP_BY(groupByClauses ...GroupByClause) SelectJsonStatement
+	HAVING(boolExpression BoolExpression) SelectJsonStatement
+	ORDER_BY(orderByClauses ...OrderByClause) SelectJsonStatement
+	LIMIT(limit int64) SelectJsonStatement
+	OFFSET(offset int64) SelectJsonStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() SelectJsonStatement
+}
+
+// SELECT_JSON_OBJ creates new SelectJsonStatement with list of projections. The first result set row is returned
+// as a single JSON object, with projection aliases as keys. Query destination has to be a pointer to struct.
+// If statement is nested into projection list it should return at most one row.
+//
+//	SELECT row_to_json(records) AS "json"
+//	FROM (
+//	     SELECT ...
+//	) AS records
+func SELECT_JSON_OBJ(projection Projection, projections ...Projection) SelectJsonStatement {
+	return newSelectJsonStatement(jet.SelectJsonObjStatementType, append([]Projection{projection}, projections...))
+}
+
+// SELECT_JSON_ARR creates new SelectJsonStatement with list of projections. All result set rows are aggregated
+// into a single JSON array of objects, with projection aliases as keys. Query destination has to be a pointer to slice.
+//
+//	SELECT COALESCE(json_agg(row_to_json(records)), '[]') AS "json"
+//	FROM (
+//	     SELECT ...
+//	) AS records
+func SELECT_JSON_ARR(projection Projection, projections ...Projection) SelectJsonStatement {
+	return newSelectJsonStatement(jet.SelectJsonArrStatementType, append([]Projection{projection}, projections...))
+}
+
+const jsonRecordsAlias = "records"
+
+func newSelectJsonStatement(statementType jet.StatementType, projections []Projection) SelectJsonStatement {
+	newSelect := &selectJsonStatementImpl{
+		statementType: statementType,
+		records:       newSelectStatement(nil, projections).(*selectStatementImpl),
+	}
+
+	jsonProjection := Raw("row_to_json(" + jsonRecordsAlias + ")")
+
+	if statementType == jet.SelectJsonArrStatementType {
+		jsonProjection = Raw("COALESCE(json_agg(row_to_json(" + jsonRecordsAlias + ")), '[]')")
+	}
+
+	newSelect.Select.ProjectionList = []Projection{jsonProjection.AS("json")}
+
+	return newSelect.init()
+}
+
+func (s *selectJsonStatementImpl) init() *selectJsonStatementImpl {
+	s.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, s.statementType, s,
+		&s.Select,
+		&s.From,
+	)
+
+	s.From.Tables = []jet.Serializer{s.records.AsTable(jsonRecordsAlias)}
+
+	return s
+}
+
+func (s *selectJsonStatementImpl) Clone() SelectJsonStatement {
+	newSelect := *s
+	jet.CloneSlices(&newSelect)
+	newSelect.records = s.records.Clone().(*selectStatementImpl)
+
+	return newSelect.init()
+}
+
+type selectJsonStatementImpl struct {
+	jet.ExpressionStatement
+
+	Select jet.ClauseSelect
+	From   jet.ClauseFrom
+
+	statementType jet.StatementType
+	records       *selectStatementImpl // select statement which rows are converted to json
+}
+
+func (s *selectJsonStatementImpl) FROM(tables ...ReadableTable) SelectJsonStatement {
+	s.records.FROM(tables...)
+	return s
+}
+
+func (s *selectJsonStatementImpl) WHERE(condition BoolExpression) SelectJsonStatement {
+	s.records.WHERE(condition)
+	return s
+}
+
+func (s *selectJsonStatementImpl) GROUP_BY(groupByClauses ...GroupByClause) SelectJsonStatement {
+	s.records.GROUP_BY(groupByClauses...)
+	return s
+}
+
+func (s *selectJsonStatementImpl) HAVING(boolExpression BoolExpression) SelectJsonStatement {
+	s.records.HAVING(boolExpression)
+	return s
+}
+
+func (s *selectJsonStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectJsonStatement {
+	s.records.ORDER_BY(orderByClauses...)
+	return s
+}
+
+func (s *selectJsonStatementImpl) LIMIT(limit int64) SelectJsonStatement {
+	s.records.LIMIT(limit)
+	return s
+}
+
+func (s *selectJsonStatementImpl) OFFSET(offset int64) SelectJsonStatement {
+	s.records.OFFSET(offset)
+	return s
+}
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"testing"
+)
+
+func TestSelectJsonObj(t *testing.T) {
+	stmt := SELECT_JSON_OBJ(table1Col1, table1ColBool).
+		FROM(table1).
+		WHERE(table1Col1.EQ(Int(11))).
+		LIMIT(1)
+
+	assertStatementSql(t, stmt, `
+SELECT (row_to_json(records)) AS "json"
+FROM (
+          SELECT table1.col1 AS "table1.col1",
+               table1.col_bool AS "table1.col_bool"
+          FROM db.table1
+          WHERE table1.col1 = $1
+          LIMIT $2
+     ) AS records;
+`, int64(11), int64(1))
+}
+
+func TestSelectJsonArr(t *testing.T) {
+	stmt := SELECT_JSON_ARR(table1Col1, table1ColFloat).
+		FROM(table1).
+		WHERE(table1Col1.GT(Int(2))).
+		GROUP_BY(table1Col1, table1ColFloat).
+		HAVING(table1ColFloat.GT(Float(1.1))).
+		ORDER_BY(table1Col1.DESC()).
+		LIMIT(10).
+		OFFSET(20)
+
+	assertDebugStatementSql(t, stmt, `
+SELECT (COALESCE(json_agg(row_to_json(records)), '[]')) AS "json"
+FROM (
+          SELECT table1.col1 AS "table1.col1",
+               table1.col_float AS "table1.col_float"
+          FROM db.table1
+          WHERE table1.col1 > 2
+          GROUP BY table1.col1, table1.col_float
+          HAVING table1.col_float > 1.1
+          ORDER BY table1.col1 DESC
+          LIMIT 10
+          OFFSET 20
+     ) AS records;
+`)
+}
+
+func TestSelectJsonNested(t *testing.T) {
+	stmt := SELECT_JSON_ARR(
+		table1Col1,
+		SELECT_JSON_OBJ(table3StrCol).
+			FROM(table3).
+			WHERE(table3Col1.EQ(table1Col1)).
+			LIMIT(1).AS("table3"),
+		SELECT_JSON_ARR(table2Col3, table2ColStr).
+			FROM(table2).
+			WHERE(table2Col3.EQ(table1Col1)).AS("table2s"),
+	).FROM(table1)
+
+	assertDebugStatementSql(t, stmt, `
+SELECT (COALESCE(json_agg(row_to_json(records)), '[]')) AS "json"
+FROM (
+          SELECT table1.col1 AS "table1.col1",
+               (
+                    SELECT (row_to_json(records)) AS "json"
+                    FROM (
+                              SELECT table3.col2 AS "table3.col2"
+                              FROM db.table3
+                              WHERE table3.col1 = table1.col1
+                              LIMIT 1
+                         ) AS records
+               ) AS "table3",
+               (
+                    SELECT (COALESCE(json_agg(row_to_json(records)), '[]')) AS "json"
+                    FROM (
+                              SELECT table2.col3 AS "table2.col3",
+                                   table2.col_str AS "table2.col_str"
+                              FROM db.table2
+                              WHERE table2.col3 = table1.col1
+                         ) AS records
+               ) AS "table2s"
+          FROM db.table1
+     ) AS records;
+`)
+}
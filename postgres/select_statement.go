@@ -40,7 +40,7 @@ var Window = jet.WindowName
 
 // SelectStatement is interface for PostgreSQL SELECT statement
 type SelectStatement interface {
-	Statement
+	ConfigurableStatement
 	jet.HasProjections
 	Expression
 
@@ -51,7 +51,14 @@ type SelectStatement interface {
 	HAVING(boolExpression BoolExpression) SelectStatement
 	WINDOW(name string) windowExpand
 	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
+	// SEEK sets ORDER BY clause and adds keyset pagination condition to the WHERE clause, selecting the rows ordered
+	// after the row with the last-seen values of the order by expressions. Without last values, first page is selected.
+	// EncodeCursor and DecodeCursor can be used to pass the last values between the requests.
+	SEEK(orderBy []OrderByClause, lastValues ...interface{}) SelectStatement
 	LIMIT(limit int64) SelectStatement
+	// LIMIT_e can be used when an integer expression is needed as limit, for instance named parameter,
+	// otherwise LIMIT can be used
+	LIMIT_e(limit IntegerExpression) SelectStatement
 	OFFSET(offset int64) SelectStatement
 	// OFFSET_e can be used when an integer expression is needed as offset, otherwise OFFSET can be used
 	OFFSET_e(offset IntegerExpression) SelectStatement
@@ -66,6 +73,10 @@ type SelectStatement interface {
 	EXCEPT_ALL(rhs SelectStatement) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() SelectStatement
 }
 
 // SELECT creates new SelectStatement with list of projections
@@ -75,28 +86,32 @@ func SELECT(projection Projection, projections ...Projection) SelectStatement {
 
 func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
 	newSelect := &selectStatementImpl{}
-	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect,
-		&newSelect.Select,
-		&newSelect.From,
-		&newSelect.Where,
-		&newSelect.GroupBy,
-		&newSelect.Having,
-		&newSelect.Window,
-		&newSelect.OrderBy,
-		&newSelect.Limit,
-		&newSelect.Offset,
-		&newSelect.Fetch,
-		&newSelect.For)
-
 	newSelect.Select.ProjectionList = projections
 	if table != nil {
 		newSelect.From.Tables = []jet.Serializer{table}
 	}
 	newSelect.Limit.Count = -1
 
-	newSelect.setOperatorsImpl.parent = newSelect
+	return newSelect.init()
+}
 
-	return newSelect
+func (s *selectStatementImpl) init() *selectStatementImpl {
+	s.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, s,
+		&s.Select,
+		&s.From,
+		&s.Where,
+		&s.GroupBy,
+		&s.Having,
+		&s.Window,
+		&s.OrderBy,
+		&s.Limit,
+		&s.Offset,
+		&s.Fetch,
+		&s.For)
+
+	s.setOperatorsImpl.parent = s
+
+	return s
 }
 
 type selectStatementImpl struct {
@@ -116,6 +131,13 @@ type selectStatementImpl struct {
 	For     jet.ClauseFor
 }
 
+func (s *selectStatementImpl) Clone() SelectStatement {
+	newSelect := *s
+	jet.CloneSlices(&newSelect)
+
+	return newSelect.init()
+}
+
 func (s *selectStatementImpl) DISTINCT(on ...jet.ColumnExpression) SelectStatement {
 	s.Select.Distinct = true
 	s.Select.DistinctOnColumns = on
@@ -152,8 +174,24 @@ func (s *selectStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectSt
 	return s
 }
 
+func (s *selectStatementImpl) SEEK(orderBy []OrderByClause, lastValues ...interface{}) SelectStatement {
+	s.OrderBy.List = orderBy
+	s.Where.Keyset = nil
+
+	if len(lastValues) > 0 {
+		s.Where.Keyset = jet.KeysetCondition(orderBy, lastValues)
+	}
+
+	return s
+}
+
 func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
-	s.Limit.Count = limit
+	s.Limit = jet.ClauseLimit{Count: limit}
+	return s
+}
+
+func (s *selectStatementImpl) LIMIT_e(limit IntegerExpression) SelectStatement {
+	s.Limit = jet.ClauseLimit{Count: -1, CountExpression: limit}
 	return s
 }
 
@@ -2,6 +2,9 @@ package postgres
 
 import (
 	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInvalidSelect(t *testing.T) {
@@ -140,3 +143,167 @@ FROM db.table1
 FOR UPDATE OF table1, table2 NOWAIT;
 `)
 }
+
+func TestSelectClone(t *testing.T) {
+	base := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.GT(Int(1))).
+		WINDOW("w1").AS(PARTITION_BY(table1ColInt))
+
+	first := base.Clone().WHERE(table1ColBool.IS_TRUE()).ORDER_BY(table1ColInt.DESC()).LIMIT(10)
+	second := base.Clone().WINDOW("w2").AS(ORDER_BY(table1ColFloat)).OFFSET(5)
+
+	assertStatementSql(t, base, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1
+WINDOW w1 AS (PARTITION BY table1.col_int);
+`, int64(1))
+
+	assertStatementSql(t, first, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_bool IS TRUE
+WINDOW w1 AS (PARTITION BY table1.col_int)
+ORDER BY table1.col_int DESC
+LIMIT $1;
+`, int64(10))
+
+	assertStatementSql(t, second, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int > $1
+WINDOW w1 AS (PARTITION BY table1.col_int), w2 AS (ORDER BY table1.col_float)
+OFFSET $2;
+`, int64(1), int64(5))
+
+	assertStatementSql(t, base.Clone().UNION(second.Clone().LIMIT(1)).Clone().LIMIT(2), `
+(
+     SELECT table1.col_int AS "table1.col_int"
+     FROM db.table1
+     WHERE table1.col_int > $1
+     WINDOW w1 AS (PARTITION BY table1.col_int)
+)
+UNION
+(
+     SELECT table1.col_int AS "table1.col_int"
+     FROM db.table1
+     WHERE table1.col_int > $2
+     WINDOW w1 AS (PARTITION BY table1.col_int), w2 AS (ORDER BY table1.col_float)
+     LIMIT $3
+     OFFSET $4
+)
+LIMIT $5;
+`, int64(1), int64(1), int64(1), int64(5), int64(2))
+}
+
+func TestSelectWithReuse(t *testing.T) {
+	cte := CTE("cte")
+	with := WITH(cte.AS(SELECT(table1ColInt).FROM(table1)))
+
+	first := with(SELECT(cte.AllColumns()).FROM(cte))
+	second := with(SELECT(Int(1)).FROM(cte))
+
+	assertStatementSql(t, first, `
+WITH cte AS (
+     SELECT table1.col_int AS "table1.col_int"
+     FROM db.table1
+)
+SELECT cte."table1.col_int" AS "table1.col_int"
+FROM cte;
+`)
+	assertStatementSql(t, second, `
+WITH cte AS (
+     SELECT table1.col_int AS "table1.col_int"
+     FROM db.table1
+)
+SELECT $1
+FROM cte;
+`, int64(1))
+}
+
+func TestSelectSeek(t *testing.T) {
+	stmt := SELECT(table1ColInt, table1ColFloat).
+		FROM(table1).
+		WHERE(table1ColBool.IS_TRUE()).
+		LIMIT(10)
+
+	orderBy := []OrderByClause{table1ColFloat.DESC(), table1ColInt.DESC()}
+
+	assertDebugStatementSql(t, stmt.Clone().SEEK(orderBy), `
+SELECT table1.col_int AS "table1.col_int",
+     table1.col_float AS "table1.col_float"
+FROM db.table1
+WHERE table1.col_bool IS TRUE
+ORDER BY table1.col_float DESC, table1.col_int DESC
+LIMIT 10;
+`)
+
+	cursor, err := EncodeCursor(2.5, int64(11))
+	require.NoError(t, err)
+	lastValues, err := DecodeCursor(cursor)
+	require.NoError(t, err)
+
+	assertDebugStatementSql(t, stmt.Clone().SEEK(orderBy, lastValues...), `
+SELECT table1.col_int AS "table1.col_int",
+     table1.col_float AS "table1.col_float"
+FROM db.table1
+WHERE table1.col_bool IS TRUE AND ((table1.col_float, table1.col_int) < (2.5, 11))
+ORDER BY table1.col_float DESC, table1.col_int DESC
+LIMIT 10;
+`)
+
+	assertDebugStatementSql(t, stmt.Clone().SEEK([]OrderByClause{table1ColFloat.ASC().NULLS_LAST(), table1ColInt.DESC()}, nil, 11), `
+SELECT table1.col_int AS "table1.col_int",
+     table1.col_float AS "table1.col_float"
+FROM db.table1
+WHERE table1.col_bool IS TRUE AND (table1.col_float IS NULL AND (table1.col_int < 11))
+ORDER BY table1.col_float ASC NULLS LAST, table1.col_int DESC
+LIMIT 10;
+`)
+}
+
+func TestSelectFormat(t *testing.T) {
+	stmt := SELECT(table1ColInt, COUNT(table2ColInt).AS("count")).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		WHERE(table1ColInt.IN(SELECT(table3ColInt).FROM(table3)).AND(table1ColBool.IS_TRUE())).
+		GROUP_BY(table1ColInt).
+		LIMIT(10)
+
+	compact := stmt.WithFormat(Format{Compact: true})
+	require.Equal(t, `SELECT table1.col_int AS "table1.col_int", COUNT(table2.col_int) AS "count" FROM db.table1 INNER JOIN db.table2 ON (table1.col_int = table2.col_int) WHERE (table1.col_int IN (SELECT table3.col_int AS "table3.col_int" FROM db.table3)) AND table1.col_bool IS TRUE GROUP BY table1.col_int LIMIT 10;`,
+		compact.DebugSql())
+	query, args := compact.Sql()
+	require.Equal(t, `SELECT table1.col_int AS "table1.col_int", COUNT(table2.col_int) AS "count" FROM db.table1 INNER JOIN db.table2 ON (table1.col_int = table2.col_int) WHERE (table1.col_int IN (SELECT table3.col_int AS "table3.col_int" FROM db.table3)) AND table1.col_bool IS TRUE GROUP BY table1.col_int LIMIT $1;`,
+		query)
+	require.Equal(t, []interface{}{int64(10)}, args)
+
+	assertDebugStatementSql(t, stmt.WithFormat(Format{Indent: 2, KeywordCase: LowerCaseKeywords}), `
+select table1.col_int as "table1.col_int",
+  count(table2.col_int) as "count"
+from db.table1
+  inner join db.table2 on (table1.col_int = table2.col_int)
+where (table1.col_int in (
+    select table3.col_int as "table3.col_int"
+    from db.table3
+  )) and table1.col_bool is true
+group by table1.col_int
+limit 10;
+`)
+}
+
+func TestDialectFormat(t *testing.T) {
+	dialect := jet.ExtendDialect(Dialect, jet.DialectParams{
+		Format: Format{Compact: true, KeywordCase: LowerCaseKeywords},
+	})
+
+	stmt := SELECT(table1ColInt).FROM(table1).WHERE(table1ColInt.EQ(Int(1))).WithDialect(dialect)
+
+	require.Equal(t, `select table1.col_int as "table1.col_int" from db.table1 where table1.col_int = 1;`, stmt.DebugSql())
+	// statement format overrides dialect format
+	require.Equal(t, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE table1.col_int = 1;
+`, stmt.WithFormat(Format{}).DebugSql())
+}
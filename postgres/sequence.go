@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Sequence is interface for PostgreSQL sequence
+type Sequence interface {
+	SchemaName() string
+	SequenceName() string
+
+	// NEXTVAL advances the sequence and returns its new value
+	NEXTVAL() IntegerExpression
+	// CURRVAL returns the value most recently obtained by NEXTVAL for the sequence in the current session
+	CURRVAL() IntegerExpression
+	// SETVAL sets the sequence current value. If isCalled is false, next NEXTVAL will return exactly the value set,
+	// otherwise NEXTVAL will advance the sequence before returning the value. isCalled is true by default.
+	SETVAL(value IntegerExpression, isCalled ...bool) IntegerExpression
+
+	// FromSchema creates new sequence with assigned schema name
+	FromSchema(schemaName string) Sequence
+}
+
+// NewSequence creates new sequence with schema name and sequence name
+func NewSequence(schemaName, name string) Sequence {
+	return &sequenceImpl{
+		schemaName: schemaName,
+		name:       name,
+	}
+}
+
+type sequenceImpl struct {
+	schemaName string
+	name       string
+}
+
+func (s *sequenceImpl) SchemaName() string {
+	return s.schemaName
+}
+
+func (s *sequenceImpl) SequenceName() string {
+	return s.name
+}
+
+func (s *sequenceImpl) NEXTVAL() IntegerExpression {
+	return IntExp(Func("NEXTVAL", s.regclass()))
+}
+
+func (s *sequenceImpl) CURRVAL() IntegerExpression {
+	return IntExp(Func("CURRVAL", s.regclass()))
+}
+
+func (s *sequenceImpl) SETVAL(value IntegerExpression, isCalled ...bool) IntegerExpression {
+	if len(isCalled) > 0 {
+		return IntExp(Func("SETVAL", s.regclass(), value, Bool(isCalled[0])))
+	}
+
+	return IntExp(Func("SETVAL", s.regclass(), value))
+}
+
+func (s *sequenceImpl) FromSchema(schemaName string) Sequence {
+	return NewSequence(schemaName, s.name)
+}
+
+// LASTVAL returns the value most recently obtained by NEXTVAL in the current session, for any sequence
+func LASTVAL() IntegerExpression {
+	return IntExp(Func("LASTVAL"))
+}
+
+// regclass returns sequence name string constant, that is converted to the sequence oid by the sequence functions
+func (s *sequenceImpl) regclass() Expression {
+	name := sequenceIdentifier(s.name)
+
+	if s.schemaName != "" {
+		name = sequenceIdentifier(s.schemaName) + "." + name
+	}
+
+	return CustomExpression(jet.Token("'" + strings.ReplaceAll(name, "'", "''") + "'"))
+}
+
+func sequenceIdentifier(name string) string {
+	if name != "" && !Dialect.IsReservedWord(name) && strings.Trim(name, "abcdefghijklmnopqrstuvwxyz0123456789_") == "" &&
+		(name[0] < '0' || name[0] > '9') {
+		return name
+	}
+
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
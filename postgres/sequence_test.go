@@ -0,0 +1,31 @@
+package postgres
+
+import "testing"
+
+var orderNumberSeq = NewSequence("public", "order_number_seq")
+
+func TestSequence(t *testing.T) {
+	assertSerialize(t, orderNumberSeq.NEXTVAL(), "NEXTVAL('public.order_number_seq')")
+	assertSerialize(t, orderNumberSeq.CURRVAL().ADD(Int(1)), "(CURRVAL('public.order_number_seq') + $1)", int64(1))
+	assertSerialize(t, orderNumberSeq.SETVAL(Int(100)), "SETVAL('public.order_number_seq', $1)", int64(100))
+	assertSerialize(t, orderNumberSeq.SETVAL(Int(1), false), "SETVAL('public.order_number_seq', $1, $2::boolean)",
+		int64(1), false)
+	assertSerialize(t, LASTVAL(), "LASTVAL()")
+}
+
+func TestSequenceQuotedName(t *testing.T) {
+	assertSerialize(t, NewSequence("", "OrderSeq").NEXTVAL(), `NEXTVAL('"OrderSeq"')`)
+	assertSerialize(t, NewSequence("My Schema", "user").NEXTVAL(), `NEXTVAL('"My Schema"."user"')`)
+	assertSerialize(t, NewSequence("public", "o'seq").NEXTVAL(), `NEXTVAL('public."o''seq"')`)
+	assertSerialize(t, orderNumberSeq.FromSchema("test").NEXTVAL(), "NEXTVAL('test.order_number_seq')")
+}
+
+func TestSequenceInsert(t *testing.T) {
+	assertStatementSql(t, table1.INSERT(table1ColInt).VALUES(orderNumberSeq.NEXTVAL()), `
+INSERT INTO db.table1 (col_int)
+VALUES (NEXTVAL('public.order_number_seq'));
+`)
+	assertDebugStatementSql(t, SELECT(orderNumberSeq.SETVAL(Int(1), false)), `
+SELECT SETVAL('public.order_number_seq', 1, FALSE::boolean);
+`, int64(1), false)
+}
@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var tenantFilter = NewTableFilter(
+	[]Table{table1, table2},
+	func(table Table) BoolExpression {
+		return IntegerColumn("tenant_id").EQ(Int(7))
+	},
+	func(table Table) []ColumnAssigment {
+		return []ColumnAssigment{IntegerColumn("tenant_id").SET(Int(7))}
+	},
+)
+
+func TestTableFilterSelect(t *testing.T) {
+	t2ColInt := IntegerColumn("col_int")
+	t2 := NewTable("db", "table2", "t2", t2ColInt)
+
+	stmt := SELECT(table1ColInt).
+		FROM(
+			table1.
+				LEFT_JOIN(t2, table1ColInt.EQ(t2ColInt)).
+				INNER_JOIN(table3, table3ColInt.EQ(table1ColInt)),
+		).
+		WHERE(table1ColInt.IN(SELECT(table2ColInt).FROM(table2)))
+
+	assertDebugStatementSql(t, stmt.WithTableFilters(tenantFilter), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     LEFT JOIN db.table2 AS t2 ON ((table1.col_int = t2.col_int) AND (t2.tenant_id = 7))
+     INNER JOIN db.table3 ON (table3.col_int = table1.col_int)
+WHERE (table1.col_int IN (
+           SELECT table2.col_int AS "table2.col_int"
+           FROM db.table2
+           WHERE table2.tenant_id = 7
+      )) AND (table1.tenant_id = 7);
+`)
+
+	// original statement is not modified
+	assertDebugStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+     LEFT JOIN db.table2 AS t2 ON (table1.col_int = t2.col_int)
+     INNER JOIN db.table3 ON (table3.col_int = table1.col_int)
+WHERE table1.col_int IN (
+           SELECT table2.col_int AS "table2.col_int"
+           FROM db.table2
+      );
+`)
+}
+
+func TestTableFilterUpdateDelete(t *testing.T) {
+	update := table1.UPDATE(table1ColInt).
+		SET(1).
+		FROM(table2).
+		WHERE(table1ColInt.EQ(table2ColInt))
+
+	assertDebugStatementSql(t, update.WithTableFilters(tenantFilter), `
+UPDATE db.table1
+SET col_int = 1
+FROM db.table2
+WHERE ((table1.col_int = table2.col_int) AND (table1.tenant_id = 7)) AND (table2.tenant_id = 7);
+`)
+
+	deleteStmt := table2.DELETE().
+		WHERE(table2ColBool.IS_TRUE()).
+		RETURNING(table2ColInt)
+
+	assertDebugStatementSql(t, deleteStmt.WithTableFilters(tenantFilter), `
+DELETE FROM db.table2
+WHERE table2.col_bool IS TRUE AND (table2.tenant_id = 7)
+RETURNING table2.col_int AS "table2.col_int";
+`)
+
+	require.PanicsWithValue(t, "jet: WHERE clause not set", func() {
+		table2.DELETE().WithTableFilters(tenantFilter).Sql()
+	})
+}
+
+func TestTableFilterInsert(t *testing.T) {
+	insert := table2.INSERT(table2ColInt, table2ColStr).
+		VALUES(1, "one").
+		VALUES(2, "two").
+		ON_CONFLICT(table2ColInt).DO_NOTHING()
+
+	assertDebugStatementSql(t, insert.WithTableFilters(tenantFilter), `
+INSERT INTO db.table2 (col_int, col_str, tenant_id)
+VALUES (1, 'one', 7),
+       (2, 'two', 7)
+ON CONFLICT (col_int) DO NOTHING;
+`)
+
+	withTenant := table3.INSERT(table3ColInt, IntegerColumn("tenant_id")).
+		VALUES(1, 8)
+
+	tenantFilter3 := NewTableFilter([]Table{table3}, func(table Table) BoolExpression {
+		return IntegerColumn("tenant_id").EQ(Int(7))
+	}, func(table Table) []ColumnAssigment {
+		return []ColumnAssigment{IntegerColumn("tenant_id").SET(Int(7))}
+	})
+
+	assertDebugStatementSql(t, withTenant.WithTableFilters(tenantFilter3), `
+INSERT INTO db.table3 (col_int, tenant_id)
+VALUES (1, 7);
+`)
+
+	upsert := table2.INSERT(table2ColInt, table2ColStr).
+		VALUES(1, "one").
+		ON_CONFLICT(table2ColInt).DO_UPDATE(SET(table2ColStr.SET(String("new"))))
+
+	assertDebugStatementSql(t, upsert.WithTableFilters(tenantFilter), `
+INSERT INTO db.table2 (col_int, col_str, tenant_id)
+VALUES (1, 'one', 7)
+ON CONFLICT (col_int) DO UPDATE
+       SET col_str = 'new'::text
+       WHERE table2.tenant_id = 7;
+`)
+
+	upsertWhere := table2.INSERT(table2ColInt, table2ColStr).
+		VALUES(1, "one").
+		ON_CONFLICT(table2ColInt).DO_UPDATE(SET(table2ColStr.SET(String("new"))).WHERE(table2ColStr.IS_NULL()))
+
+	assertDebugStatementSql(t, upsertWhere.WithTableFilters(tenantFilter), `
+INSERT INTO db.table2 (col_int, col_str, tenant_id)
+VALUES (1, 'one', 7)
+ON CONFLICT (col_int) DO UPDATE
+       SET col_str = 'new'::text
+       WHERE table2.col_str IS NULL AND (table2.tenant_id = 7);
+`)
+
+	insertQuery := table2.INSERT(table2ColInt).QUERY(SELECT(table3ColInt).FROM(table3))
+
+	require.PanicsWithValue(t, "jet: table filter assigments can not be applied to INSERT statement with query", func() {
+		insertQuery.WithTableFilters(tenantFilter).Sql()
+	})
+
+	recorder := &execRecorder{}
+	_, err := insertQuery.ExecContext(ContextWithTableFilters(context.Background(), tenantFilter), recorder)
+	require.EqualError(t, err, "jet: table filter assigments can not be applied to INSERT statement with query")
+	require.Empty(t, recorder.query)
+}
+
+type execRecorder struct {
+	query string
+	args  []interface{}
+}
+
+func (e *execRecorder) ExecContext(_ context.Context, query string, args ...interface{}) (sql.Result, error) {
+	e.query, e.args = query, args
+	return driver.RowsAffected(1), nil
+}
+
+func TestTableFilterContext(t *testing.T) {
+	recorder := &execRecorder{}
+	ctx := ContextWithTableFilters(context.Background(), tenantFilter)
+
+	_, err := table1.DELETE().WHERE(table1ColInt.EQ(Int(2))).ExecContext(ctx, recorder)
+	require.NoError(t, err)
+	require.Equal(t, `
+DELETE FROM db.table1
+WHERE (table1.col_int = $1) AND (table1.tenant_id = $2);
+`, recorder.query)
+	require.Equal(t, []interface{}{int64(2), int64(7)}, recorder.args)
+}
+
+func TestTableFilterChainedStatementOptions(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.EQ(IntParam("id")))
+
+	require.Equal(t, `SELECT table1.col_int AS "table1.col_int" FROM db.table1 WHERE (table1.col_int = 11) AND (table1.tenant_id = 7);`,
+		stmt.WithArgs(map[string]interface{}{"id": 11}).
+			WithTableFilters(tenantFilter).
+			WithFormat(Format{Compact: true}).
+			DebugSql())
+}
@@ -122,3 +122,81 @@ FROM db.table1,
      db.table3;
 `)
 }
+
+var (
+	softDeleteColInt       = IntegerColumn("col_int")
+	softDeleteColDeletedAt = TimestampzColumn("deleted_at")
+	softDeleteTable        = NewSoftDeleteTable("db", "soft_table", "", softDeleteColDeletedAt, softDeleteColInt, softDeleteColDeletedAt)
+)
+
+func TestSoftDeleteTableSelect(t *testing.T) {
+	stmt := SELECT(table1ColInt, softDeleteColInt).
+		FROM(
+			table1.LEFT_JOIN(softDeleteTable, softDeleteColInt.EQ(table1ColInt)),
+		).
+		WHERE(table1ColInt.IN(SELECT(softDeleteColInt).FROM(softDeleteTable)))
+
+	assertDebugStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int",
+     soft_table.col_int AS "soft_table.col_int"
+FROM db.table1
+     LEFT JOIN db.soft_table ON ((soft_table.col_int = table1.col_int) AND soft_table.deleted_at IS NULL)
+WHERE table1.col_int IN (
+           SELECT soft_table.col_int AS "soft_table.col_int"
+           FROM db.soft_table
+           WHERE soft_table.deleted_at IS NULL
+      );
+`)
+
+	assertDebugStatementSql(t, stmt.Unscoped(), `
+SELECT table1.col_int AS "table1.col_int",
+     soft_table.col_int AS "soft_table.col_int"
+FROM db.table1
+     LEFT JOIN db.soft_table ON (soft_table.col_int = table1.col_int)
+WHERE table1.col_int IN (
+           SELECT soft_table.col_int AS "soft_table.col_int"
+           FROM db.soft_table
+      );
+`)
+}
+
+func TestSoftDeleteTableUpdate(t *testing.T) {
+	assertDebugStatementSql(t, softDeleteTable.UPDATE(softDeleteColInt).SET(Int(1)).WHERE(softDeleteColInt.EQ(Int(2))), `
+UPDATE db.soft_table
+SET col_int = 1
+WHERE (soft_table.col_int = 2) AND soft_table.deleted_at IS NULL;
+`)
+}
+
+func TestSoftDeleteTableDelete(t *testing.T) {
+	stmt := softDeleteTable.DELETE().
+		WHERE(softDeleteColInt.EQ(Int(2))).
+		RETURNING(softDeleteColInt)
+
+	assertDebugStatementSql(t, stmt, `
+UPDATE db.soft_table
+SET deleted_at = CURRENT_TIMESTAMP
+WHERE (soft_table.col_int = 2) AND soft_table.deleted_at IS NULL
+RETURNING soft_table.col_int AS "soft_table.col_int";
+`)
+
+	assertDebugStatementSql(t, stmt.Unscoped(), `
+DELETE FROM db.soft_table
+WHERE soft_table.col_int = 2
+RETURNING soft_table.col_int AS "soft_table.col_int";
+`)
+
+	assertDebugStatementSql(t, softDeleteTable.DELETE().USING(table1).WHERE(softDeleteColInt.EQ(table1ColInt)), `
+UPDATE db.soft_table
+SET deleted_at = CURRENT_TIMESTAMP
+FROM db.table1
+WHERE (soft_table.col_int = table1.col_int) AND soft_table.deleted_at IS NULL;
+`)
+}
+
+func TestSoftDeleteTableInsert(t *testing.T) {
+	assertDebugStatementSql(t, softDeleteTable.INSERT(softDeleteColInt).VALUES(1), `
+INSERT INTO db.soft_table (col_int)
+VALUES (1);
+`)
+}
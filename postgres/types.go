@@ -5,9 +5,17 @@ import "github.com/go-jet/jet/v2/internal/jet"
 // Statement is common interface for all statements(SELECT, INSERT, UPDATE, DELETE, LOCK)
 type Statement = jet.Statement
 
+// ConfigurableStatement is implemented by all the statements constructed using statement builders. It extends Statement
+// with prepared statement creation, and with the methods returning new statement with the changed serialization or
+// execution options.
+type ConfigurableStatement = jet.ConfigurableStatement
+
 // Rows wraps sql.Rows type with a support for query result mapping
 type Rows = jet.Rows
 
+// PreparedStatement is a statement prepared over database connection or transaction
+type PreparedStatement = jet.PreparedStatement
+
 // Projection is interface for all projection types. Types that can be part of, for instance SELECT clause.
 type Projection = jet.Projection
 
@@ -35,3 +43,107 @@ var SetQueryLogger = jet.SetQueryLogger
 
 // QueryInfo contains information about executed query
 type QueryInfo = jet.QueryInfo
+
+// Interceptor intercepts statement execution. Interceptor should call next handler to continue the execution.
+type Interceptor = jet.Interceptor
+
+// ExecutionHandler executes the statement described by execution
+type ExecutionHandler = jet.ExecutionHandler
+
+// Execution contains information about the statement being executed
+type Execution = jet.Execution
+
+// InterceptedDB is a database connection or transaction with the list of statement execution interceptors.
+type InterceptedDB = jet.InterceptedDB
+
+// WithInterceptors wraps database connection or transaction with the list of statement execution interceptors.
+var WithInterceptors = jet.WithInterceptors
+
+// ContextWithInterceptors returns a copy of context carrying the list of statement execution interceptors.
+var ContextWithInterceptors = jet.ContextWithInterceptors
+
+// QueryLoggerInterceptor returns interceptor which calls query logger function after each statement execution.
+var QueryLoggerInterceptor = jet.QueryLoggerInterceptor
+
+// List of statement execution types
+const (
+	QueryExecution = jet.QueryExecution
+	ExecExecution  = jet.ExecExecution
+	RowsExecution  = jet.RowsExecution
+)
+
+// StatementType is type of the SQL statement
+type StatementType = jet.StatementType
+
+// List of statement types
+const (
+	SelectStatementType        = jet.SelectStatementType
+	InsertStatementType        = jet.InsertStatementType
+	UpdateStatementType        = jet.UpdateStatementType
+	DeleteStatementType        = jet.DeleteStatementType
+	SetStatementType           = jet.SetStatementType
+	LockStatementType          = jet.LockStatementType
+	WithStatementType          = jet.WithStatementType
+	CallStatementType          = jet.CallStatementType
+	SelectJsonObjStatementType = jet.SelectJsonObjStatementType
+	SelectJsonArrStatementType = jet.SelectJsonArrStatementType
+)
+
+// Node is a statement tree node passed to the Visitor
+type Node = jet.Node
+
+// NodeKind is a kind of the statement tree node
+type NodeKind = jet.NodeKind
+
+// List of statement tree node kinds
+const (
+	StatementNode = jet.StatementNode
+	ClauseNode    = jet.ClauseNode
+	TableNode     = jet.TableNode
+	ColumnNode    = jet.ColumnNode
+	LiteralNode   = jet.LiteralNode
+)
+
+// Visitor is a function called for each node of the statement tree
+type Visitor = jet.Visitor
+
+// Walk calls visitor for each statement, clause, table, column and literal of the statement tree, in the order they
+// appear in the serialized statement.
+var Walk = jet.Walk
+
+// StatementInfo contains information about the statement, collected by walking statement tree
+type StatementInfo = jet.StatementInfo
+
+// TableReference is a table referenced by the statement
+type TableReference = jet.TableReference
+
+// ColumnReference is a column referenced by the statement
+type ColumnReference = jet.ColumnReference
+
+// Inspect walks the statement tree and returns statement type, clauses, referenced tables, columns and literals.
+var Inspect = jet.Inspect
+
+// ErrStaleObject is returned by Exec of the UPDATE statement of the versioned table model, if no rows are updated
+// because the row version does not match the model version.
+var ErrStaleObject = jet.ErrStaleObject
+
+// EncodeCursor encodes the last-seen values of the keyset pagination order by expressions into opaque cursor string
+var EncodeCursor = jet.EncodeCursor
+
+// DecodeCursor decodes the cursor created with EncodeCursor into the list of last-seen values
+var DecodeCursor = jet.DecodeCursor
+
+// ErrInvalidCursor is returned by DecodeCursor if the cursor is malformed
+var ErrInvalidCursor = jet.ErrInvalidCursor
+
+// Format is formatting of the serialized SQL statements. Format is set per statement with ConfigurableStatement WithFormat method.
+type Format = jet.Format
+
+// KeywordCase is letter case of the SQL keywords in the serialized statements
+type KeywordCase = jet.KeywordCase
+
+// List of keyword cases
+const (
+	UpperCaseKeywords = jet.UpperCaseKeywords
+	LowerCaseKeywords = jet.LowerCaseKeywords
+)
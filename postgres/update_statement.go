@@ -14,6 +14,10 @@ type UpdateStatement interface {
 	FROM(tables ...ReadableTable) UpdateStatement
 	WHERE(expression BoolExpression) UpdateStatement
 	RETURNING(projections ...Projection) UpdateStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() UpdateStatement
 }
 
 type updateStatementImpl struct {
@@ -29,19 +33,30 @@ type updateStatementImpl struct {
 
 func newUpdateStatement(table WritableTable, columns []jet.Column) UpdateStatement {
 	update := &updateStatementImpl{}
-	update.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, update,
-		&update.Update,
-		&update.Set,
-		&update.SetNew,
-		&update.From,
-		&update.Where,
-		&update.Returning)
-
 	update.Update.Table = table
 	update.Set.Columns = columns
 	update.Where.Mandatory = true
 
-	return update
+	return update.init()
+}
+
+func (u *updateStatementImpl) init() *updateStatementImpl {
+	u.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, u,
+		&u.Update,
+		&u.Set,
+		&u.SetNew,
+		&u.From,
+		&u.Where,
+		&u.Returning)
+
+	return u
+}
+
+func (u *updateStatementImpl) Clone() UpdateStatement {
+	newUpdate := *u
+	jet.CloneSlices(&newUpdate)
+
+	return newUpdate.init()
 }
 
 func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) UpdateStatement {
@@ -60,7 +75,7 @@ func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) Upda
 }
 
 func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
-	u.Set.Values = jet.UnwindRowFromModel(u.Set.Columns, data)
+	u.Set.Columns, u.Set.Values, u.Where.VersionCheck = jet.UnwindVersionedRowFromModel(u.Update.Table, u.Set.Columns, data)
 	return u
 }
 
@@ -84,12 +99,16 @@ type clauseSet struct {
 	Values  []jet.Serializer
 }
 
+func (s *clauseSet) ClauseName() string {
+	return "SET"
+}
+
 func (s *clauseSet) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 	if len(s.Values) == 0 {
 		return
 	}
 	out.NewLine()
-	out.WriteString("SET")
+	out.WriteKeyword("SET")
 
 	if len(s.Columns) == 0 {
 		panic("jet: no columns selected")
@@ -1,8 +1,14 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
 	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestUpdateWithOneValue(t *testing.T) {
@@ -60,3 +66,131 @@ func TestInvalidInputs(t *testing.T) {
 	assertStatementSqlErr(t, table1.UPDATE(table1ColInt).SET(1), "jet: WHERE clause not set")
 	assertStatementSqlErr(t, table1.UPDATE(nil).SET(1), "jet: nil column in columns list")
 }
+
+func TestUpdateClone(t *testing.T) {
+	base := table1.UPDATE().
+		SET(table1ColInt.SET(Int(1))).
+		WHERE(table1ColInt.GT(Int(2)))
+
+	returning := base.Clone().RETURNING(table1ColInt)
+	where := base.Clone().WHERE(table1ColBool.IS_FALSE())
+
+	assertDebugStatementSql(t, base, `
+UPDATE db.table1
+SET col_int = 1
+WHERE table1.col_int > 2;
+`)
+	assertDebugStatementSql(t, returning, `
+UPDATE db.table1
+SET col_int = 1
+WHERE table1.col_int > 2
+RETURNING table1.col_int AS "table1.col_int";
+`)
+	assertDebugStatementSql(t, where, `
+UPDATE db.table1
+SET col_int = 1
+WHERE table1.col_bool IS FALSE;
+`)
+}
+
+var (
+	versionedColID      = IntegerColumn("id")
+	versionedColName    = StringColumn("name")
+	versionedColVersion = IntegerColumn("version")
+	versionedTable      = NewTableWithOptions("db", "versioned", "", TableOptions{VersionColumn: versionedColVersion},
+		versionedColID, versionedColName, versionedColVersion)
+)
+
+type versionedModel struct {
+	ID      int64
+	Name    string
+	Version int64
+}
+
+type rowsAffectedExecutor int64
+
+func (r rowsAffectedExecutor) ExecContext(_ context.Context, _ string, _ ...interface{}) (sql.Result, error) {
+	return driver.RowsAffected(r), nil
+}
+
+func TestUpdateVersionedModel(t *testing.T) {
+	model := versionedModel{ID: 1, Name: "name", Version: 3}
+
+	assertDebugStatementSql(t, versionedTable.UPDATE(versionedColName, versionedColVersion).
+		MODEL(model).
+		WHERE(versionedColID.EQ(Int(model.ID))), `
+UPDATE db.versioned
+SET (name, version) = ('name', (versioned.version + 1))
+WHERE (versioned.id = 1) AND (versioned.version = 3);
+`)
+
+	// version column is added to the SET clause if not listed
+	assertDebugStatementSql(t, versionedTable.UPDATE(versionedColName).
+		MODEL(model).
+		WHERE(versionedColID.EQ(Int(model.ID))), `
+UPDATE db.versioned
+SET (name, version) = ('name', (versioned.version + 1))
+WHERE (versioned.id = 1) AND (versioned.version = 3);
+`)
+
+	// SET values are not version checked
+	assertDebugStatementSql(t, versionedTable.UPDATE(versionedColName).
+		SET(String("name")).
+		WHERE(versionedColID.EQ(Int(model.ID))), `
+UPDATE db.versioned
+SET name = 'name'::text
+WHERE versioned.id = 1;
+`)
+}
+
+func TestUpdateVersionedModelExec(t *testing.T) {
+	stmt := versionedTable.UPDATE(versionedColName).
+		MODEL(versionedModel{ID: 1, Name: "name", Version: 3}).
+		WHERE(versionedColID.EQ(Int(1)))
+
+	_, err := stmt.Exec(rowsAffectedExecutor(1))
+	require.NoError(t, err)
+
+	_, err = stmt.Exec(rowsAffectedExecutor(0))
+	require.ErrorIs(t, err, ErrStaleObject)
+
+	_, err = table1.UPDATE(table1ColInt).SET(Int(1)).WHERE(table1ColInt.EQ(Int(2))).Exec(rowsAffectedExecutor(0))
+	require.NoError(t, err)
+}
+
+type unusedQueryable struct{}
+
+func (unusedQueryable) QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("not expected")
+}
+
+func TestUpdateVersionedModelQuery(t *testing.T) {
+	stmt := versionedTable.UPDATE(versionedColName).
+		MODEL(versionedModel{ID: 1, Name: "name", Version: 3}).
+		WHERE(versionedColID.EQ(Int(1))).
+		RETURNING(versionedColVersion)
+
+	rowsProcessed := func(rows int64) context.Context {
+		return ContextWithInterceptors(context.Background(), func(ctx context.Context, execution *Execution, next ExecutionHandler) error {
+			execution.RowsProcessed = rows
+			return nil
+		})
+	}
+
+	var dest []versionedModel
+
+	require.NoError(t, stmt.QueryContext(rowsProcessed(1), unusedQueryable{}, &dest))
+	require.ErrorIs(t, stmt.QueryContext(rowsProcessed(0), unusedQueryable{}, &dest), ErrStaleObject)
+}
+
+func TestUpdateVersionedModelNilVersion(t *testing.T) {
+	type nullableVersionModel struct {
+		ID      int64
+		Name    string
+		Version *int64
+	}
+
+	require.PanicsWithValue(t, "jet: model version is nil, version column 'version' has to be set", func() {
+		versionedTable.UPDATE(versionedColName).MODEL(nullableVersionModel{ID: 1, Name: "name"})
+	})
+}
@@ -0,0 +1,156 @@
+package postgres
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalk(t *testing.T) {
+	subQuery := SELECT(table2ColInt).FROM(table2).WHERE(table2ColBool.IS_TRUE()).AsTable("sub")
+
+	stmt := SELECT(table1ColInt, subQuery.AllColumns()).
+		FROM(table1.INNER_JOIN(subQuery, table1ColInt.EQ(IntegerColumn("col_int").From(subQuery)))).
+		WHERE(table1ColFloat.GT(Float(1.1))).
+		LIMIT(10)
+
+	type visit struct {
+		Kind  NodeKind
+		Depth int
+		Name  string
+	}
+
+	var visits []visit
+
+	Walk(stmt, func(node Node) {
+		name := ""
+
+		switch node.Kind {
+		case StatementNode:
+			name = string(node.StatementType)
+		case ClauseNode:
+			name = node.ClauseName
+		case TableNode:
+			name = node.Schema + "." + node.Table
+		case ColumnNode:
+			name = node.Table + "." + node.Column
+		case LiteralNode:
+			name = fmt.Sprint(node.Value)
+		}
+
+		visits = append(visits, visit{node.Kind, node.Depth, name})
+	})
+
+	require.Equal(t, []visit{
+		{StatementNode, 0, "SELECT"},
+		{ClauseNode, 0, "SELECT"},
+		{ColumnNode, 0, "table1.col_int"},
+		{ColumnNode, 0, "sub.table2.col_int"},
+		{ClauseNode, 0, "FROM"},
+		{TableNode, 0, "db.table1"},
+		{StatementNode, 1, "SELECT"},
+		{ClauseNode, 1, "SELECT"},
+		{ColumnNode, 1, "table2.col_int"},
+		{ClauseNode, 1, "FROM"},
+		{TableNode, 1, "db.table2"},
+		{ClauseNode, 1, "WHERE"},
+		{ColumnNode, 1, "table2.col_bool"},
+		{ColumnNode, 0, "table1.col_int"},
+		{ColumnNode, 0, "sub.col_int"},
+		{ClauseNode, 0, "WHERE"},
+		{ColumnNode, 0, "table1.col_float"},
+		{LiteralNode, 0, "1.1"},
+		{ClauseNode, 0, "LIMIT"},
+		{LiteralNode, 0, "10"},
+	}, visits)
+}
+
+func TestInspect(t *testing.T) {
+	info := Inspect(SELECT(table1ColInt, table1ColFloat).
+		FROM(table1).
+		WHERE(table1ColInt.IN(SELECT(table2ColInt).FROM(table2).WHERE(table2ColStr.EQ(String("str"))))).
+		ORDER_BY(table1ColInt))
+
+	require.Equal(t, SelectStatementType, info.Type)
+	require.False(t, info.Writes)
+	require.Equal(t, []string{"SELECT", "FROM", "WHERE", "ORDER BY"}, info.Clauses)
+	require.Equal(t, []TableReference{{Schema: "db", Name: "table1"}, {Schema: "db", Name: "table2"}}, info.Tables)
+	require.Equal(t, []ColumnReference{
+		{Table: "table1", Name: "col_int"},
+		{Table: "table1", Name: "col_float"},
+		{Table: "table2", Name: "col_int"},
+		{Table: "table2", Name: "col_str"},
+	}, info.Columns)
+	require.Equal(t, []interface{}{"str"}, info.Literals)
+	require.Len(t, info.Projections, 2)
+}
+
+func TestInspectWrites(t *testing.T) {
+	deleteInfo := Inspect(table1.DELETE().WHERE(table1ColInt.EQ(Int(1))).RETURNING(table1ColInt))
+
+	require.Equal(t, DeleteStatementType, deleteInfo.Type)
+	require.True(t, deleteInfo.Writes)
+	require.True(t, deleteInfo.HasClause("WHERE"))
+	require.Equal(t, []string{"DELETE", "WHERE", "RETURNING"}, deleteInfo.Clauses)
+	require.Len(t, deleteInfo.Projections, 1)
+
+	insertInfo := Inspect(table1.INSERT(table1ColInt).VALUES(1).ON_CONFLICT(table1ColInt).DO_NOTHING())
+
+	require.Equal(t, InsertStatementType, insertInfo.Type)
+	require.True(t, insertInfo.Writes)
+	require.Equal(t, []string{"INSERT", "VALUES", "ON CONFLICT"}, insertInfo.Clauses)
+
+	removed := CTE("removed")
+
+	withInfo := Inspect(WITH(
+		removed.AS(table2.DELETE().WHERE(table2ColBool.IS_TRUE()).RETURNING(table2ColInt)),
+	)(
+		SELECT(removed.AllColumns()).FROM(removed),
+	))
+
+	require.Equal(t, SelectStatementType, withInfo.Type)
+	require.True(t, withInfo.Writes)
+	require.Equal(t, []string{"SELECT", "FROM"}, withInfo.Clauses)
+	require.Equal(t, []TableReference{{Schema: "db", Name: "table2"}}, withInfo.Tables)
+}
+
+func TestInspectNamedArguments(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.EQ(IntParam("id")))
+
+	require.Equal(t, []string{"SELECT", "FROM", "WHERE"}, Inspect(stmt).Clauses)
+	require.Equal(t, []string{"SELECT", "FROM", "WHERE"}, Inspect(stmt.WithArgs(map[string]interface{}{"id": 1})).Clauses)
+}
+
+func TestInspectNonDebuggableLiteral(t *testing.T) {
+	type point struct{ X, Y int }
+
+	info := Inspect(SELECT(table1ColInt).
+		FROM(table1).
+		WHERE(table1ColInt.EQ(IntExp(jet.Literal(point{1, 2})))))
+
+	require.Equal(t, []interface{}{point{1, 2}}, info.Literals)
+}
+
+type customStatement struct {
+	ConfigurableStatement
+}
+
+func TestWalkCustomStatement(t *testing.T) {
+	var stmt Statement = customStatement{ConfigurableStatement: SELECT(table1ColInt).FROM(table1)}
+
+	require.Equal(t, []string{"SELECT", "FROM"}, Inspect(stmt).Clauses)
+}
+
+type mockStatement struct {
+	Statement // Statement interface can be implemented outside of jet, for instance by mocks
+}
+
+func TestWalkNonJetStatement(t *testing.T) {
+	require.PanicsWithValue(t, "jet: statement of type postgres.mockStatement can not be walked", func() {
+		Inspect(mockStatement{})
+	})
+}
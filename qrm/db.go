@@ -23,3 +23,18 @@ type Queryable interface {
 type Executable interface {
 	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
 }
+
+// Preparable interface for sql PrepareContext method
+type Preparable interface {
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
+}
+
+// Rows is the interface of the query result set used by the query result mapping.
+// *sql.Rows implements Rows interface, and result sets of other database drivers can be adapted to it.
+type Rows interface {
+	Columns() ([]string, error)
+	Next() bool
+	Scan(dest ...interface{}) error
+	Close() error
+	Err() error
+}
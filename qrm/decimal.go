@@ -0,0 +1,198 @@
+package qrm
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number, stored as its textual representation. It can be used as a model field type
+// for SQL numeric and decimal columns, when the precision of the database value has to be preserved. Decimal is scanned
+// from the driver value without conversion to float64, and it is passed back to the database as a string.
+// Zero value of Decimal is 0.
+type Decimal struct {
+	value string
+}
+
+// NewDecimal creates new Decimal from the textual representation of a decimal number, for instance "-12.345" or "1e-3".
+func NewDecimal(value string) (Decimal, error) {
+	value = strings.TrimSpace(value)
+
+	if !isDecimalString(value) {
+		return Decimal{}, fmt.Errorf("jet: invalid decimal value %q", value)
+	}
+
+	return Decimal{value: value}, nil
+}
+
+// MustDecimal creates new Decimal, and panics if the value is not a valid decimal number.
+func MustDecimal(value string) Decimal {
+	decimal, err := NewDecimal(value)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return decimal
+}
+
+// DecimalFromInt creates new Decimal from the integer value
+func DecimalFromInt(value int64) Decimal {
+	return Decimal{value: strconv.FormatInt(value, 10)}
+}
+
+// String returns textual representation of the decimal number
+func (d Decimal) String() string {
+	if d.value == "" {
+		return "0"
+	}
+
+	return d.value
+}
+
+// Rat returns decimal number as big.Rat, which can be used for the exact arithmetic
+func (d Decimal) Rat() *big.Rat {
+	rat, _ := new(big.Rat).SetString(d.String())
+	return rat
+}
+
+// Float64 returns the nearest float64 value of the decimal number. Precision might be lost.
+func (d Decimal) Float64() float64 {
+	float, _ := d.Rat().Float64()
+	return float
+}
+
+// Cmp compares two decimal numbers, and returns -1 if d < other, 0 if d == other and +1 if d > other.
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+// Equal returns true if both decimals represent the same number, regardless of the textual representation.
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+// Scan implements the sql.Scanner interface
+func (d *Decimal) Scan(value interface{}) error {
+	var str string
+
+	switch v := value.(type) {
+	case string:
+		str = v
+	case []byte:
+		str = string(v)
+	case int64:
+		str = strconv.FormatInt(v, 10)
+	case float64:
+		// some drivers (for instance sqlite) can return only float64 values for numeric columns
+		str = strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		str = strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case nil:
+		return fmt.Errorf("jet: can't scan NULL into Decimal")
+	default:
+		return fmt.Errorf("jet: can't scan %T into Decimal", value)
+	}
+
+	decimal, err := NewDecimal(str)
+
+	if err != nil {
+		return err
+	}
+
+	*d = decimal
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (d Decimal) Value() (driver.Value, error) {
+	return d.String(), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface
+func (d *Decimal) UnmarshalText(text []byte) error {
+	decimal, err := NewDecimal(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*d = decimal
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface. Decimal is marshaled as JSON number.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Both JSON numbers and JSON strings are accepted.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	var number json.Number
+
+	if err := json.Unmarshal(data, &number); err != nil {
+		return fmt.Errorf("jet: can't unmarshal %s into Decimal: %w", data, err)
+	}
+
+	return d.UnmarshalText([]byte(number))
+}
+
+// isDecimalString checks if the value is a decimal number: optional sign, digits with optional fraction, and optional exponent.
+func isDecimalString(value string) bool {
+	i := 0
+
+	if i < len(value) && (value[i] == '+' || value[i] == '-') {
+		i++
+	}
+
+	intDigits := countDigits(value[i:])
+	i += intDigits
+
+	fractionDigits := 0
+
+	if i < len(value) && value[i] == '.' {
+		i++
+		fractionDigits = countDigits(value[i:])
+		i += fractionDigits
+	}
+
+	if intDigits == 0 && fractionDigits == 0 {
+		return false
+	}
+
+	if i < len(value) && (value[i] == 'e' || value[i] == 'E') {
+		i++
+
+		if i < len(value) && (value[i] == '+' || value[i] == '-') {
+			i++
+		}
+
+		exponentDigits := countDigits(value[i:])
+
+		if exponentDigits == 0 {
+			return false
+		}
+
+		i += exponentDigits
+	}
+
+	return i == len(value)
+}
+
+func countDigits(value string) int {
+	count := 0
+
+	for count < len(value) && value[count] >= '0' && value[count] <= '9' {
+		count++
+	}
+
+	return count
+}
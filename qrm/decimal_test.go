@@ -0,0 +1,119 @@
+package qrm
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecimal(t *testing.T) {
+	for _, valid := range []string{"0", "-12.345", "+1.", ".5", "1e-3", "1.11111111111111111111", " 42 "} {
+		_, err := NewDecimal(valid)
+		require.NoError(t, err, valid)
+	}
+
+	for _, invalid := range []string{"", "-", ".", "1e", "1.2.3", "NaN", "Infinity", "1/3", "0x10"} {
+		_, err := NewDecimal(invalid)
+		require.EqualError(t, err, "jet: invalid decimal value \""+invalid+"\"")
+	}
+
+	require.Panics(t, func() { MustDecimal("abc") })
+	require.Equal(t, "0", Decimal{}.String())
+	require.Equal(t, "-7", DecimalFromInt(-7).String())
+}
+
+func TestDecimalCompare(t *testing.T) {
+	require.True(t, MustDecimal("1.10").Equal(MustDecimal("1.1")))
+	require.True(t, MustDecimal("1e2").Equal(DecimalFromInt(100)))
+	require.Equal(t, -1, MustDecimal("0.1").Cmp(MustDecimal("0.11")))
+	require.Equal(t, 1, MustDecimal("10000000000000000000.000000000000000001").Cmp(MustDecimal("10000000000000000000")))
+	require.Equal(t, 0.5, MustDecimal("0.5").Float64())
+}
+
+func TestDecimalScanAndValue(t *testing.T) {
+	var d Decimal
+
+	require.NoError(t, d.Scan([]byte("1234567890.12345678901234567890")))
+	require.Equal(t, "1234567890.12345678901234567890", d.String())
+
+	require.NoError(t, d.Scan("-0.001"))
+	require.Equal(t, "-0.001", d.String())
+
+	require.NoError(t, d.Scan(int64(12)))
+	require.Equal(t, "12", d.String())
+
+	require.NoError(t, d.Scan(float64(1.5)))
+	require.Equal(t, "1.5", d.String())
+
+	require.EqualError(t, d.Scan(nil), "jet: can't scan NULL into Decimal")
+	require.EqualError(t, d.Scan(true), "jet: can't scan bool into Decimal")
+	require.EqualError(t, d.Scan("NaN"), `jet: invalid decimal value "NaN"`)
+
+	value, err := MustDecimal("9999999999999999.99").Value()
+	require.NoError(t, err)
+	require.Equal(t, "9999999999999999.99", value)
+}
+
+func TestDecimalJSON(t *testing.T) {
+	type Invoice struct {
+		Total Decimal
+		Tax   Null[Decimal]
+	}
+
+	data, err := json.Marshal(Invoice{Total: MustDecimal("100.10")})
+	require.NoError(t, err)
+	require.Equal(t, `{"Total":100.10,"Tax":null}`, string(data))
+
+	var invoice Invoice
+	require.NoError(t, json.Unmarshal([]byte(`{"Total":0.30000000000000000001,"Tax":"21.00"}`), &invoice))
+	require.Equal(t, "0.30000000000000000001", invoice.Total.String())
+	require.Equal(t, NewNull(MustDecimal("21.00")), invoice.Tax)
+
+	require.Error(t, json.Unmarshal([]byte(`{"Total":true}`), &invoice))
+}
+
+func TestDecimalQueryResultMapping(t *testing.T) {
+	type Product struct {
+		ID       int64 `sql:"primary_key"`
+		Price    Decimal
+		Discount *Decimal
+		Weight   decimal.Decimal
+		Tax      Null[decimal.Decimal]
+	}
+
+	var dest []Product
+
+	scanContext := newTestScanContext(
+		[]string{"product.id", "product.price", "product.discount", "product.weight", "product.tax"},
+		int64(1), []byte("19.99999999999999999999"), nil, "0.30000000000000000001", []byte("1.000000000000000000001"),
+	)
+	scanContext.destPath = newDestPath(reflect.TypeOf(dest).String(), false)
+
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Len(t, dest, 1)
+	require.Equal(t, "19.99999999999999999999", dest[0].Price.String())
+	require.Nil(t, dest[0].Discount)
+	require.Equal(t, "0.30000000000000000001", dest[0].Weight.String())
+	require.True(t, dest[0].Tax.Valid)
+	require.Equal(t, "1.000000000000000000001", dest[0].Tax.V.String())
+}
+
+func TestDecimalJsonMapping(t *testing.T) {
+	type Product struct {
+		ID     int64 `sql:"primary_key"`
+		Price  Decimal
+		Weight *decimal.Decimal
+	}
+
+	var dest []Product
+
+	err := mapJsonArr(t, `[{"product.id": 1, "product.price": 19.99999999999999999999, "product.weight": 0.30000000000000000001}]`, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 1)
+	require.Equal(t, "19.99999999999999999999", dest[0].Price.String())
+	require.Equal(t, "0.30000000000000000001", dest[0].Weight.String())
+}
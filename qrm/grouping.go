@@ -0,0 +1,74 @@
+package qrm
+
+import (
+	"reflect"
+	"sync"
+)
+
+// GroupingStrategy defines how query result mapping groups result set rows into unique destination objects
+type GroupingStrategy int
+
+const (
+	// GroupByPrimaryKey groups objects by the fields tagged with `sql:"primary_key"`. If struct type does not
+	// have primary key fields, objects are grouped by the fields tagged with `sql:"group_key"`. If there are
+	// none of those, objects are grouped by the keys of their nested objects, or each row creates a new object.
+	// This is the default grouping strategy.
+	GroupByPrimaryKey GroupingStrategy = iota
+	// GroupByGroupKey groups objects only by the fields tagged with `sql:"group_key"`
+	GroupByGroupKey
+	// GroupByAllFields groups objects by the values of all the scalar fields. Useful for projections without
+	// primary key, like views, aggregates and DTOs.
+	GroupByAllFields
+	// NoGrouping disables grouping, every result set row creates a new object
+	NoGrouping
+)
+
+var (
+	groupingStrategiesMutex sync.RWMutex
+	groupingStrategies      = map[reflect.Type]GroupingStrategy{}
+)
+
+// SetGroupingStrategy sets grouping strategy for the destination struct type T.
+// Grouping strategies are usually set once, during application initialization.
+func SetGroupingStrategy[T any](strategy GroupingStrategy) {
+	structType := reflect.TypeOf((*T)(nil)).Elem()
+
+	groupingStrategiesMutex.Lock()
+	defer groupingStrategiesMutex.Unlock()
+
+	if strategy == GroupByPrimaryKey {
+		delete(groupingStrategies, structType)
+		return
+	}
+
+	groupingStrategies[structType] = strategy
+}
+
+func getGroupingStrategy(structType reflect.Type) GroupingStrategy {
+	groupingStrategiesMutex.RLock()
+	defer groupingStrategiesMutex.RUnlock()
+
+	return groupingStrategies[structType]
+}
+
+func isGroupKeyField(field reflect.StructField, strategy GroupingStrategy, primaryKeyOverwrites []string, useGroupKeyTag bool) bool {
+	if strategy == GroupByAllFields {
+		return isSimpleModelType(field.Type) || implementsScannerType(field.Type)
+	}
+
+	if useGroupKeyTag {
+		return field.Tag.Get("sql") == "group_key"
+	}
+
+	return isPrimaryKey(field, primaryKeyOverwrites)
+}
+
+func hasPrimaryKeyField(structType reflect.Type) bool {
+	for i := 0; i < structType.NumField(); i++ {
+		if isPrimaryKey(structType.Field(i), nil) {
+			return true
+		}
+	}
+
+	return false
+}
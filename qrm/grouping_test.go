@@ -0,0 +1,108 @@
+package qrm
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type groupingCity struct {
+	Country string
+	City    string
+	Streets []groupingStreet
+}
+
+type groupingStreet struct {
+	Name string
+}
+
+type groupingCountry struct {
+	Code   string `sql:"group_key"`
+	Name   string
+	Cities []groupingCountryCity
+}
+
+type groupingCountryCity struct {
+	City string
+}
+
+var groupingColumns = []string{"grouping_city.country", "grouping_city.city", "grouping_street.name"}
+
+var groupingRows = [][]interface{}{
+	{"HR", "Zagreb", "Ilica"},
+	{"HR", "Zagreb", "Vlaska"},
+	{"HR", "Split", "Marmontova"},
+	{"HR", "Split", "Marmontova"},
+}
+
+func mapTestRows(t *testing.T, aliases []string, rows [][]interface{}, destPtr interface{}) {
+	scanContext := newTestScanContext(aliases, rows[0]...)
+	scanContext.rowNum = 0
+
+	for _, row := range rows {
+		for i, value := range row {
+			*(scanContext.row[i].(*interface{})) = value
+		}
+		scanContext.rowNum++
+
+		_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(destPtr), nil)
+		require.NoError(t, err)
+	}
+}
+
+func TestGroupByPrimaryKeyWithoutKeys(t *testing.T) {
+	var dest []groupingCity
+
+	mapTestRows(t, groupingColumns, groupingRows, &dest)
+	require.Len(t, dest, 4)
+}
+
+func TestGroupByAllFields(t *testing.T) {
+	SetGroupingStrategy[groupingCity](GroupByAllFields)
+	SetGroupingStrategy[groupingStreet](GroupByAllFields)
+	defer SetGroupingStrategy[groupingCity](GroupByPrimaryKey)
+	defer SetGroupingStrategy[groupingStreet](GroupByPrimaryKey)
+
+	var dest []groupingCity
+
+	mapTestRows(t, groupingColumns, groupingRows, &dest)
+	require.Equal(t, []groupingCity{
+		{Country: "HR", City: "Zagreb", Streets: []groupingStreet{{Name: "Ilica"}, {Name: "Vlaska"}}},
+		{Country: "HR", City: "Split", Streets: []groupingStreet{{Name: "Marmontova"}}},
+	}, dest)
+}
+
+func TestNoGrouping(t *testing.T) {
+	SetGroupingStrategy[groupingCity](GroupByAllFields)
+	SetGroupingStrategy[groupingStreet](NoGrouping)
+	defer SetGroupingStrategy[groupingCity](GroupByPrimaryKey)
+	defer SetGroupingStrategy[groupingStreet](GroupByPrimaryKey)
+
+	var dest []groupingCity
+
+	mapTestRows(t, groupingColumns, groupingRows, &dest)
+	require.Equal(t, []groupingCity{
+		{Country: "HR", City: "Zagreb", Streets: []groupingStreet{{Name: "Ilica"}, {Name: "Vlaska"}}},
+		{Country: "HR", City: "Split", Streets: []groupingStreet{{Name: "Marmontova"}, {Name: "Marmontova"}}},
+	}, dest)
+}
+
+func TestGroupByGroupKeyTag(t *testing.T) {
+	SetGroupingStrategy[groupingCountryCity](GroupByAllFields)
+	defer SetGroupingStrategy[groupingCountryCity](GroupByPrimaryKey)
+
+	var dest []groupingCountry
+
+	mapTestRows(t, []string{"grouping_country.code", "grouping_country.name", "grouping_country_city.city"}, [][]interface{}{
+		{"HR", "Croatia", "Zagreb"},
+		{"HR", "Croatia", "Split"},
+		{"SI", "Slovenia", "Ljubljana"},
+		{"HR", "Croatia", "Split"},
+	}, &dest)
+
+	require.Equal(t, []groupingCountry{
+		{Code: "HR", Name: "Croatia", Cities: []groupingCountryCity{{City: "Zagreb"}, {City: "Split"}}},
+		{Code: "SI", Name: "Slovenia", Cities: []groupingCountryCity{{City: "Ljubljana"}}},
+	}, dest)
+}
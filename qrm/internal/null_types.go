@@ -76,6 +76,12 @@ var formats = []string{
 	"15:04:05.999999",            // pgx
 }
 
+// postgres json and jsonb serialize timestamps using ISO 8601 format
+var isoFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+}
+
 func tryParseAsTime(value interface{}) (time.Time, bool) {
 
 	var timeStr string
@@ -91,6 +97,14 @@ func tryParseAsTime(value interface{}) (time.Time, bool) {
 		return time.Time{}, false
 	}
 
+	for _, format := range isoFormats {
+		t, err := time.Parse(format, timeStr)
+
+		if err == nil {
+			return t, true
+		}
+	}
+
 	for _, format := range formats {
 		formatLen := min.Int(len(format), len(timeStr))
 		t, err := time.Parse(format[:formatLen], timeStr)
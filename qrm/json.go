@@ -0,0 +1,442 @@
+package qrm
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-jet/jet/v2/internal/utils/must"
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
+)
+
+// QueryJsonObj executes Query Result Mapping (QRM) of `query` returning a single row with a single JSON object column,
+// into destination struct `destPtr`. JSON object keys are matched with destination fields using the same alias rules
+// as for the row result mapping. Nested JSON objects and arrays are mapped into the struct and slice fields matching
+// JSON key, while the other struct fields are mapped from the same JSON object.
+// If query result set is empty, method returns qrm.ErrNoRows.
+func QueryJsonObj(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+	must.BeInitializedPtr(db, "jet: db is nil")
+	must.BeInitializedPtr(destPtr, "jet: destination is nil")
+	must.BeTypeKind(destPtr, reflect.Ptr, "jet: destination has to be a pointer to struct")
+	must.TypeBeOfKind(reflect.TypeOf(destPtr).Elem(), reflect.Struct, "jet: destination has to be a pointer to struct")
+
+	jsonValue, err := queryJsonValue(ctx, db, query, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("jet: %w", err)
+	}
+
+	if jsonValue == nil {
+		return 0, ErrNoRows
+	}
+
+	obj, ok := jsonValue.(map[string]interface{})
+
+	if !ok {
+		return 0, fmt.Errorf("jet: query result is not JSON object, got %T", jsonValue)
+	}
+
+	destPtrValue := reflect.ValueOf(destPtr)
+	mapper := newJsonMapper(destPtrValue.Type().Elem().String())
+	typesVisited := newTypeStack()
+
+	_, err = mapper.mapObjToStruct(normalizeJsonObj(obj), destPtrValue, nil, &typesVisited)
+
+	if err != nil {
+		return 1, fmt.Errorf("jet: %w", err)
+	}
+
+	return 1, nil
+}
+
+// QueryJsonArr executes Query Result Mapping (QRM) of `query` returning a single row with a single JSON array column,
+// into destination slice `destPtr`. JSON array elements are mapped the same way as for the QueryJsonObj.
+// If query result set is empty or JSON array is null, destination is left unchanged.
+func QueryJsonArr(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
+	must.BeInitializedPtr(db, "jet: db is nil")
+	must.BeInitializedPtr(destPtr, "jet: destination is nil")
+	must.BeTypeKind(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice")
+	must.TypeBeOfKind(reflect.TypeOf(destPtr).Elem(), reflect.Slice, "jet: destination has to be a pointer to slice")
+
+	jsonValue, err := queryJsonValue(ctx, db, query, args)
+
+	if err != nil {
+		return 0, fmt.Errorf("jet: %w", err)
+	}
+
+	if jsonValue == nil {
+		return 0, nil
+	}
+
+	arr, ok := jsonValue.([]interface{})
+
+	if !ok {
+		return 0, fmt.Errorf("jet: query result is not JSON array, got %T", jsonValue)
+	}
+
+	destPtrValue := reflect.ValueOf(destPtr)
+	mapper := newJsonMapper(destPtrValue.Type().Elem().String())
+
+	_, err = mapper.mapArrToSlice(arr, destPtrValue, nil)
+
+	if err != nil {
+		return int64(len(arr)), fmt.Errorf("jet: %w", err)
+	}
+
+	return int64(len(arr)), nil
+}
+
+// queryJsonValue returns decoded JSON value of the first column of the first row
+func queryJsonValue(ctx context.Context, db Queryable, query string, args []interface{}) (interface{}, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	rows, err := db.QueryContext(ctx, query, args...)
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, rows.Err()
+	}
+
+	var data interface{}
+
+	err = rows.Scan(&data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	jsonValue, err := decodeJson(data)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = rows.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonValue, rows.Err()
+}
+
+func decodeJson(data interface{}) (interface{}, error) {
+	var jsonData []byte
+
+	switch d := data.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		jsonData = d
+	case string:
+		jsonData = []byte(d)
+	default:
+		return nil, fmt.Errorf("can't decode JSON from %T", data)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+
+	var ret interface{}
+
+	err := decoder.Decode(&ret)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	return ret, nil
+}
+
+type jsonField struct {
+	key   string // original JSON key, used only for error reporting
+	value interface{}
+}
+
+// normalizeJsonObj converts JSON object keys into common identifiers, so they can be matched with destination fields
+func normalizeJsonObj(obj map[string]interface{}) map[string]jsonField {
+	ret := make(map[string]jsonField, len(obj))
+
+	for key, value := range obj {
+		ret[aliasToCommonIdentifier(key)] = jsonField{key: key, value: value}
+	}
+
+	return ret
+}
+
+type jsonMapper struct {
+	destPath destPath // used only for error reporting
+}
+
+func newJsonMapper(destTypeName string) *jsonMapper {
+	return &jsonMapper{
+		destPath: newDestPath(destTypeName, false),
+	}
+}
+
+// mapObjToStruct maps normalized JSON object into struct. Types visited are tracked only while the struct fields
+// are mapped from the same JSON object, to prevent circular dependency mapping.
+func (m *jsonMapper) mapObjToStruct(
+	obj map[string]jsonField,
+	structPtrValue reflect.Value,
+	parentField *reflect.StructField,
+	typesVisited *typeStack,
+) (updated bool, err error) {
+
+	structType := structPtrValue.Type().Elem()
+
+	if typesVisited.contains(&structType) {
+		return false, nil
+	}
+
+	typesVisited.push(&structType)
+	defer typesVisited.pop()
+
+	structValue := structPtrValue.Elem()
+	typeName := getTypeName(structType, parentField)
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		fieldValue := structValue.Field(i)
+
+		if !fieldValue.CanSet() { // private field
+			continue
+		}
+
+		newTypeName, fieldName := getTypeAndFieldName(typeName, field)
+		jsonField, found := obj[typeAndFieldToCommonIdentifier(newTypeName, fieldName)]
+
+		if isJsonScalarType(field.Type) {
+			if !found {
+				continue
+			}
+
+			if jsonField.value == nil {
+				setZeroValue(fieldValue)
+				continue
+			}
+
+			updated = true
+
+			err = assignJsonValue(jsonField.value, fieldValue)
+
+			if err != nil {
+				return updated, newMappingError(assignError, jsonField.key, 1, &m.destPath, jsonField.value, field.Name, field.Type, err)
+			}
+
+			continue
+		}
+
+		if !found { // nested JSON objects and arrays usually have alias without type name, for instance 'films'
+			jsonField, found = obj[typeAndFieldToCommonIdentifier("", fieldName)]
+		}
+
+		var changed bool
+
+		m.destPath.pushField(field.Name)
+
+		if found {
+			changed, err = m.mapValueToDestination(jsonField.value, fieldValue, &field)
+		} else {
+			changed, err = m.mapObjToDestination(obj, fieldValue, &field, typesVisited)
+		}
+
+		m.destPath.pop()
+
+		if err != nil {
+			return
+		}
+
+		if changed {
+			updated = true
+		}
+	}
+
+	return
+}
+
+// mapValueToDestination maps nested JSON value into struct or slice destination
+func (m *jsonMapper) mapValueToDestination(value interface{}, dest reflect.Value, field *reflect.StructField) (updated bool, err error) {
+	if value == nil {
+		setZeroValue(dest)
+		return false, nil
+	}
+
+	return withDestinationPtr(dest, func(destPtrValue reflect.Value) (bool, error) {
+		destValueKind := destPtrValue.Elem().Kind()
+
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if destValueKind == reflect.Struct {
+				typesVisited := newTypeStack()
+				return m.mapObjToStruct(normalizeJsonObj(v), destPtrValue, field, &typesVisited)
+			} else if destValueKind == reflect.Slice {
+				return m.mapArrToSlice([]interface{}{v}, destPtrValue, field)
+			}
+		case []interface{}:
+			if destValueKind == reflect.Slice {
+				return m.mapArrToSlice(v, destPtrValue, field)
+			}
+		}
+
+		return false, fmt.Errorf("can't map JSON %T to '%s %s' at %s", value, field.Name, field.Type.String(), m.destPath.String())
+	})
+}
+
+// mapObjToDestination maps the same JSON object into struct or slice destination, when there is no JSON key matching the destination
+func (m *jsonMapper) mapObjToDestination(
+	obj map[string]jsonField,
+	dest reflect.Value,
+	field *reflect.StructField,
+	typesVisited *typeStack,
+) (updated bool, err error) {
+
+	return withDestinationPtr(dest, func(destPtrValue reflect.Value) (bool, error) {
+		switch destPtrValue.Elem().Kind() {
+		case reflect.Struct:
+			return m.mapObjToStruct(obj, destPtrValue, field, typesVisited)
+		case reflect.Slice:
+			if isJsonScalarType(getSliceElemType(destPtrValue)) {
+				return false, nil
+			}
+
+			elemPtrValue := newElemPtrValueForSlice(destPtrValue)
+
+			m.destPath.pushIndex(destPtrValue.Elem().Len())
+			updated, err := m.mapObjToStruct(obj, elemPtrValue, field, typesVisited)
+			m.destPath.pop()
+
+			if err != nil || !updated {
+				return false, err
+			}
+
+			return true, appendElemToSlice(destPtrValue, elemPtrValue)
+		default:
+			panic("jet: unsupported dest type: " + field.Name + " " + field.Type.String())
+		}
+	})
+}
+
+func (m *jsonMapper) mapArrToSlice(arr []interface{}, slicePtrValue reflect.Value, field *reflect.StructField) (updated bool, err error) {
+	sliceElemType := getSliceElemType(slicePtrValue)
+	isScalarElem := isJsonScalarType(sliceElemType)
+
+	if !isScalarElem {
+		must.TypeBeOfKind(sliceElemType, reflect.Struct, "jet: unsupported slice element type"+fieldToString(field))
+	}
+
+	for _, elem := range arr {
+		if elem == nil {
+			continue
+		}
+
+		elemPtrValue := newElemPtrValueForSlice(slicePtrValue)
+		elemUpdated := true
+
+		m.destPath.pushIndex(slicePtrValue.Elem().Len())
+
+		if isScalarElem {
+			err = assignJsonValue(elem, elemPtrValue.Elem())
+
+			if err != nil {
+				err = newMappingError(appendError, "", 1, &m.destPath, elem, "", sliceElemType, err)
+			}
+		} else if obj, ok := elem.(map[string]interface{}); ok {
+			typesVisited := newTypeStack()
+			elemUpdated, err = m.mapObjToStruct(normalizeJsonObj(obj), elemPtrValue, field, &typesVisited)
+		} else {
+			err = fmt.Errorf("can't map JSON %T to %s at %s", elem, sliceElemType.String(), m.destPath.String())
+		}
+
+		m.destPath.pop()
+
+		if err != nil {
+			return
+		}
+
+		if elemUpdated {
+			updated = true
+			err = appendElemToSlice(slicePtrValue, elemPtrValue)
+
+			if err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// withDestinationPtr calls mapFunc with a pointer to destination. If destination is nil pointer, destination is
+// initialized only if mapFunc reports destination as updated.
+func withDestinationPtr(dest reflect.Value, mapFunc func(destPtrValue reflect.Value) (bool, error)) (updated bool, err error) {
+	var destPtrValue reflect.Value
+
+	if dest.Kind() != reflect.Ptr {
+		destPtrValue = dest.Addr()
+	} else if dest.IsNil() {
+		destPtrValue = reflect.New(dest.Type().Elem())
+	} else {
+		destPtrValue = dest
+	}
+
+	updated, err = mapFunc(destPtrValue)
+
+	if err != nil {
+		return
+	}
+
+	if dest.Kind() == reflect.Ptr && dest.IsNil() && updated {
+		dest.Set(destPtrValue)
+	}
+
+	return
+}
+
+// isJsonScalarType returns true if destination type is mapped from a single JSON value
+func isJsonScalarType(fieldType reflect.Type) bool {
+	return isSimpleModelType(fieldType) || implementsScannerType(fieldType)
+}
+
+func assignJsonValue(value interface{}, destination reflect.Value) error {
+	switch v := value.(type) {
+	case json.Number:
+		value = string(v)
+	case map[string]interface{}, []interface{}: // json or jsonb column
+		data, err := json.Marshal(v)
+
+		if err != nil {
+			return err
+		}
+
+		value = data
+	case string:
+		// postgres encodes bytea as hex string
+		if indirectType(destination.Type()) == byteArrayType && strings.HasPrefix(v, `\x`) {
+			data, err := hex.DecodeString(v[2:])
+
+			if err != nil {
+				return err
+			}
+
+			value = data
+		}
+	}
+
+	if implementsScannerType(destination.Type()) && !typeconv.HasFromDriver(indirectType(destination.Type())) {
+		initializeValueIfNilPtr(destination)
+		return getScanner(destination).Scan(value)
+	}
+
+	return assign(reflect.ValueOf(value), destination)
+}
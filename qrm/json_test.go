@@ -0,0 +1,148 @@
+package qrm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonLanguage struct {
+	LanguageID int32 `sql:"primary_key"`
+	Name       string
+}
+
+type jsonFilm struct {
+	FilmID      int32 `sql:"primary_key"`
+	Title       string
+	Rating      *float64
+	LastUpdate  time.Time
+	Language    jsonLanguage
+	Tags        []string
+	SpecialCode uuid.UUID
+}
+
+type jsonActor struct {
+	ActorID   int32 `sql:"primary_key"`
+	FirstName string
+	Films     []jsonFilm
+}
+
+func mapJsonArr(t *testing.T, data string, destPtr interface{}) error {
+	jsonValue, err := decodeJson([]byte(data))
+	require.NoError(t, err)
+
+	destPtrValue := reflect.ValueOf(destPtr)
+	mapper := newJsonMapper(destPtrValue.Type().Elem().String())
+
+	_, err = mapper.mapArrToSlice(jsonValue.([]interface{}), destPtrValue, nil)
+	return err
+}
+
+func TestJsonMapping(t *testing.T) {
+	data := `[
+	{
+		"json_actor.actor_id": 1,
+		"json_actor.first_name": "Penelope",
+		"films": [
+			{
+				"json_film.film_id": 20,
+				"json_film.title": "Amelie Hellfighters",
+				"json_film.rating": 4.5,
+				"json_film.last_update": "2013-05-26T14:50:58.951",
+				"json_film.tags": ["drama", "comedy"],
+				"json_film.special_code": "b68dbff4-a87d-11e9-a7f2-98ded00c39c6",
+				"json_language.language_id": 1,
+				"json_language.name": "English"
+			},
+			{
+				"json_film.film_id": 24,
+				"json_film.title": "Analyze Hoosiers",
+				"json_film.rating": null,
+				"json_film.last_update": "2013-05-26T14:50:58.951+02:00",
+				"json_film.tags": null,
+				"json_film.special_code": "b68dbff4-a87d-11e9-a7f2-98ded00c39c6",
+				"language": {"json_language.language_id": 2, "json_language.name": "Italian"}
+			}
+		]
+	},
+	{
+		"json_actor.actor_id": 2,
+		"json_actor.first_name": "Nick",
+		"films": []
+	}
+]`
+
+	var dest []jsonActor
+
+	err := mapJsonArr(t, data, &dest)
+	require.NoError(t, err)
+
+	rating := 4.5
+	specialCode := uuid.MustParse("b68dbff4-a87d-11e9-a7f2-98ded00c39c6")
+
+	require.Equal(t, []jsonActor{
+		{
+			ActorID:   1,
+			FirstName: "Penelope",
+			Films: []jsonFilm{
+				{
+					FilmID:      20,
+					Title:       "Amelie Hellfighters",
+					Rating:      &rating,
+					LastUpdate:  time.Date(2013, 5, 26, 14, 50, 58, 951000000, time.UTC),
+					Language:    jsonLanguage{LanguageID: 1, Name: "English"},
+					Tags:        []string{"drama", "comedy"},
+					SpecialCode: specialCode,
+				},
+				{
+					FilmID:      24,
+					Title:       "Analyze Hoosiers",
+					LastUpdate:  time.Date(2013, 5, 26, 14, 50, 58, 951000000, time.FixedZone("", 2*60*60)),
+					Language:    jsonLanguage{LanguageID: 2, Name: "Italian"},
+					SpecialCode: specialCode,
+				},
+			},
+		},
+		{
+			ActorID:   2,
+			FirstName: "Nick",
+		},
+	}, dest)
+}
+
+func TestJsonMappingAliasTag(t *testing.T) {
+	var dest []struct {
+		ID    int64  `alias:"actor.actor_id"`
+		Names []byte `alias:"names"`
+		Film  *struct {
+			Title string
+		} `alias:"film"`
+	}
+
+	err := mapJsonArr(t, `[{"actor.actor_id": 3, "names": "\\x4a6f686e", "film.title": "Alone Trip"}, {"actor.actor_id": 4}]`, &dest)
+	require.NoError(t, err)
+	require.Len(t, dest, 2)
+	require.Equal(t, int64(3), dest[0].ID)
+	require.Equal(t, []byte("John"), dest[0].Names)
+	require.Equal(t, "Alone Trip", dest[0].Film.Title)
+	require.Equal(t, int64(4), dest[1].ID)
+	require.Nil(t, dest[1].Film)
+}
+
+func TestJsonMappingError(t *testing.T) {
+	var dest []jsonActor
+
+	err := mapJsonArr(t, `[{"json_actor.actor_id": 1, "films": [{"json_film.film_id": 2, "json_language.language_id": "abc"}]}]`, &dest)
+
+	var mappingErr *MappingError
+	require.True(t, errors.As(err, &mappingErr))
+	require.Equal(t, "json_language.language_id", mappingErr.Column)
+	require.Equal(t, "[]qrm.jsonActor[0].Films[0].Language.LanguageID", mappingErr.Path)
+
+	err = mapJsonArr(t, `[{"json_actor.actor_id": 1, "films": 11}]`, &dest)
+	require.EqualError(t, err, "can't map JSON json.Number to 'Films []qrm.jsonFilm' at []qrm.jsonActor[0].Films")
+}
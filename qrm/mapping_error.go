@@ -0,0 +1,150 @@
+package qrm
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// MappingError is returned when a scanned column value can not be mapped into the destination.
+// It can be retrieved from the returned error chain using errors.As.
+type MappingError struct {
+	// Column is the result set column alias, for instance 'film.title'
+	Column string
+	// RowNum is the one based number of the result set row being mapped
+	RowNum int64
+	// Path is the destination path the value was mapped into, for instance '[]Dest[3].Films[0].Language.Name'
+	Path string
+	// SourceType is the type of the value scanned from the database
+	SourceType reflect.Type
+	// DestinationType is the type of the destination field
+	DestinationType reflect.Type
+	// Err is the underlying scan or assign error
+	Err error
+
+	value     interface{}
+	fieldName string
+	kind      mappingErrorKind
+}
+
+type mappingErrorKind int
+
+const (
+	assignError mappingErrorKind = iota
+	scanError
+	appendError
+)
+
+func (e *MappingError) Error() string {
+	var description string
+
+	switch e.kind {
+	case scanError:
+		description = fmt.Sprintf("can't scan %T(%q) to '%s %s'", e.value, e.value, e.fieldName, e.DestinationType.String())
+	case appendError:
+		description = fmt.Sprintf("can't append %T to []%s slice", e.value, e.DestinationType.String())
+	default:
+		description = fmt.Sprintf("can't assign %T(%q) to '%s %s'", e.value, e.value, e.fieldName, e.DestinationType.String())
+	}
+
+	return fmt.Sprintf("%s (column %q, row %d, path %s): %s", description, e.Column, e.RowNum, e.Path, e.Err)
+}
+
+// Unwrap returns the underlying scan or assign error
+func (e *MappingError) Unwrap() error {
+	return e.Err
+}
+
+func (s *ScanContext) newMappingError(kind mappingErrorKind, rowIndex int, value interface{}, field string, destType reflect.Type, err error) *MappingError {
+	return newMappingError(kind, s.columnAlias(rowIndex), s.rowNum, &s.destPath, value, field, destType, err)
+}
+
+func newMappingError(
+	kind mappingErrorKind,
+	column string,
+	rowNum int64,
+	destPath *destPath,
+	value interface{},
+	field string,
+	destType reflect.Type,
+	err error,
+) *MappingError {
+	path := destPath.String()
+
+	if kind != appendError {
+		path = concat(path, ".", field)
+	}
+
+	return &MappingError{
+		Column:          column,
+		RowNum:          rowNum,
+		Path:            path,
+		SourceType:      reflect.TypeOf(value),
+		DestinationType: destType,
+		Err:             err,
+		value:           value,
+		fieldName:       field,
+		kind:            kind,
+	}
+}
+
+// destPath tracks the position in the destination currently being mapped. Path elements are
+// pushed and popped while the row is mapped, and formatted into string only when an error occurs.
+type destPath struct {
+	root string
+	// the index of the temporary slice used to map into the struct destination is not part of the path
+	skipRootIndex bool
+	elems         []destPathElem
+}
+
+type destPathElem struct {
+	field string // empty for the slice index elements
+	index int
+}
+
+func newDestPath(root string, skipRootIndex bool) destPath {
+	return destPath{
+		root:          root,
+		skipRootIndex: skipRootIndex,
+		elems:         make([]destPathElem, 0, 10),
+	}
+}
+
+func (p *destPath) pushField(name string) {
+	p.elems = append(p.elems, destPathElem{field: name})
+}
+
+func (p *destPath) pushIndex(index int) {
+	p.elems = append(p.elems, destPathElem{index: index})
+}
+
+func (p *destPath) pop() {
+	if len(p.elems) > 0 {
+		p.elems = p.elems[:len(p.elems)-1]
+	}
+}
+
+func (p *destPath) String() string {
+	var b strings.Builder
+
+	b.WriteString(p.root)
+
+	for i, elem := range p.elems {
+		if elem.field != "" {
+			b.WriteString(".")
+			b.WriteString(elem.field)
+			continue
+		}
+
+		if i == 0 && p.skipRootIndex {
+			continue
+		}
+
+		b.WriteString("[")
+		b.WriteString(strconv.Itoa(elem.index))
+		b.WriteString("]")
+	}
+
+	return b.String()
+}
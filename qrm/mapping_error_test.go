@@ -0,0 +1,100 @@
+package qrm
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestScanContext(aliases []string, values ...interface{}) *ScanContext {
+	commonIdentToColumnIndex := map[string]int{}
+
+	for i, alias := range aliases {
+		commonIdentToColumnIndex[toCommonIdentifier(alias)] = i
+	}
+
+	row := createScanSlice(len(values))
+
+	for i, value := range values {
+		*(row[i].(*interface{})) = value
+	}
+
+	return &ScanContext{
+		rowNum:                   1,
+		row:                      row,
+		columnAliases:            aliases,
+		uniqueDestObjectsMap:     make(map[string]int),
+		commonIdentToColumnIndex: commonIdentToColumnIndex,
+		groupKeyInfoCache:        make(map[string]groupKeyInfo),
+		typeInfoMap:              make(map[string]typeInfo),
+		typesVisited:             newTypeStack(),
+	}
+}
+
+func TestMappingErrorNested(t *testing.T) {
+	type Language struct {
+		LanguageID int64 `sql:"primary_key"`
+		Name       bool
+	}
+
+	type Film struct {
+		FilmID   int64 `sql:"primary_key"`
+		Language Language
+	}
+
+	type Actor struct {
+		ActorID int64 `sql:"primary_key"`
+		Films   []Film
+	}
+
+	scanContext := newTestScanContext(
+		[]string{"actor.actor_id", "film.film_id", "language.language_id", "language.name"},
+		int64(1), int64(2), int64(3), "English",
+	)
+
+	var dest []Actor
+	scanContext.destPath = newDestPath(reflect.TypeOf(dest).String(), false)
+
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.Error(t, err)
+
+	var mappingErr *MappingError
+	require.True(t, errors.As(err, &mappingErr))
+	require.Equal(t, "language.name", mappingErr.Column)
+	require.Equal(t, int64(1), mappingErr.RowNum)
+	require.Equal(t, "[]qrm.Actor[0].Films[0].Language.Name", mappingErr.Path)
+	require.Equal(t, reflect.TypeOf(""), mappingErr.SourceType)
+	require.Equal(t, reflect.TypeOf(true), mappingErr.DestinationType)
+	require.EqualError(t, err, `can't assign string("English") to 'Name bool' (column "language.name", row 1, path []qrm.Actor[0].Films[0].Language.Name): sql/driver: couldn't convert "English" into type bool`)
+}
+
+func TestMappingErrorStructDestination(t *testing.T) {
+	type Inventory struct {
+		InventoryID int32
+		FilmID      bool
+	}
+
+	scanContext := newTestScanContext([]string{"inventory.inventory_id", "inventory.film_id"}, int64(1), int64(2))
+
+	var dest []*Inventory
+	scanContext.destPath = newDestPath(reflect.TypeOf(Inventory{}).String(), true)
+
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.EqualError(t, err, `can't assign int64('\x02') to 'FilmID bool' (column "inventory.film_id", row 1, path qrm.Inventory.FilmID): can't assign int64(2) to bool`)
+}
+
+func TestMappingErrorBaseTypeSlice(t *testing.T) {
+	scanContext := newTestScanContext([]string{"inventory.film_id"}, int64(2))
+
+	var dest []bool
+	scanContext.destPath = newDestPath(reflect.TypeOf(dest).String(), false)
+
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+
+	var mappingErr *MappingError
+	require.True(t, errors.As(err, &mappingErr))
+	require.Equal(t, "[]bool[0]", mappingErr.Path)
+	require.EqualError(t, err, `can't append int64 to []bool slice (column "inventory.film_id", row 1, path []bool[0]): can't assign int64(2) to bool`)
+}
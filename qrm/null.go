@@ -0,0 +1,118 @@
+package qrm
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
+)
+
+// Null is a nullable value of type T. It can be used as a model field type for the nullable columns, instead of a
+// pointer type. Null implements sql.Scanner and driver.Valuer interfaces, and it is marshaled to JSON as the value,
+// or as null if the value is not valid.
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewNull creates new valid Null value
+func NewNull[T any](value T) Null[T] {
+	return Null[T]{V: value, Valid: true}
+}
+
+// NullFromPtr creates new Null value from the pointer. Nil pointer creates NULL value.
+func NullFromPtr[T any](value *T) Null[T] {
+	if value == nil {
+		return Null[T]{}
+	}
+
+	return NewNull(*value)
+}
+
+// Ptr returns pointer to the value, or nil if the value is NULL
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+
+	value := n.V
+	return &value
+}
+
+// ValueOrZero returns the value, or zero value of the type T if the value is NULL
+func (n Null[T]) ValueOrZero() T {
+	if !n.Valid {
+		var zero T
+		return zero
+	}
+
+	return n.V
+}
+
+// Scan implements the sql.Scanner interface
+func (n *Null[T]) Scan(value interface{}) error {
+	var zero T
+	n.V, n.Valid = zero, false
+
+	if value == nil {
+		return nil
+	}
+
+	if scanner, ok := interface{}(&n.V).(sql.Scanner); ok {
+		if err := scanner.Scan(value); err != nil {
+			return err
+		}
+	} else if err := assign(reflect.ValueOf(value), reflect.ValueOf(&n.V).Elem()); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (n Null[T]) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+
+	value, err := typeconv.ToDriverValue(n.V)
+	if err != nil {
+		return nil, err
+	}
+
+	if valuer, ok := value.(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(value)
+}
+
+// MarshalJSON implements the json.Marshaler interface
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	var zero T
+	n.V, n.Valid = zero, false
+
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	if err := json.Unmarshal(data, &n.V); err != nil {
+		return err
+	}
+
+	n.Valid = true
+	return nil
+}
@@ -0,0 +1,93 @@
+package qrm
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullScan(t *testing.T) {
+	var str Null[string]
+	require.NoError(t, str.Scan([]byte("text")))
+	require.Equal(t, NewNull("text"), str)
+	require.NoError(t, str.Scan(nil))
+	require.Equal(t, Null[string]{}, str)
+
+	var integer Null[int32]
+	require.NoError(t, integer.Scan(int64(11)))
+	require.Equal(t, NewNull(int32(11)), integer)
+	require.Error(t, integer.Scan("eleven"))
+	require.False(t, integer.Valid)
+
+	var id Null[uuid.UUID]
+	require.NoError(t, id.Scan("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"))
+	require.Equal(t, uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11"), id.V)
+
+	var tm Null[time.Time]
+	now := time.Now()
+	require.NoError(t, tm.Scan(now))
+	require.Equal(t, NewNull(now), tm)
+}
+
+func TestNullValue(t *testing.T) {
+	value, err := Null[string]{}.Value()
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	value, err = NewNull(int32(11)).Value()
+	require.NoError(t, err)
+	require.Equal(t, int64(11), value)
+
+	type email string
+	value, err = NewNull(email("a@b.com")).Value()
+	require.NoError(t, err)
+	require.Equal(t, "a@b.com", value)
+
+	value, err = NewNull(uuid.MustParse("a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11")).Value()
+	require.NoError(t, err)
+	require.Equal(t, "a0eebc99-9c0b-4ef8-bb6d-6bb9bd380a11", value)
+}
+
+func TestNullJSON(t *testing.T) {
+	type Person struct {
+		Name Null[string]
+		Age  Null[int]
+	}
+
+	data, err := json.Marshal(Person{Name: NewNull("John")})
+	require.NoError(t, err)
+	require.Equal(t, `{"Name":"John","Age":null}`, string(data))
+
+	var person Person
+	require.NoError(t, json.Unmarshal([]byte(`{"Name":null,"Age":30}`), &person))
+	require.Equal(t, Person{Age: NewNull(30)}, person)
+}
+
+func TestNullPtr(t *testing.T) {
+	require.Nil(t, Null[string]{}.Ptr())
+	require.Equal(t, "text", *NewNull("text").Ptr())
+	require.Equal(t, NewNull("text"), NullFromPtr(NewNull("text").Ptr()))
+	require.Equal(t, Null[string]{}, NullFromPtr[string](nil))
+	require.Equal(t, 0, Null[int]{}.ValueOrZero())
+}
+
+func TestNullQueryResultMapping(t *testing.T) {
+	type Person struct {
+		ID   int64 `sql:"primary_key"`
+		Name Null[string]
+		Age  Null[int32]
+	}
+
+	var dest []Person
+
+	scanContext := newTestScanContext([]string{"person.id", "person.name", "person.age"}, int64(1), "John", nil)
+	scanContext.destPath = newDestPath(reflect.TypeOf(dest).String(), false)
+
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Equal(t, []Person{{ID: 1, Name: NewNull("John")}}, dest)
+}
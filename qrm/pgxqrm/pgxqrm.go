@@ -0,0 +1,248 @@
+// Package pgxqrm executes jet statements directly over pgx connections, pools and transactions, without
+// database/sql and the pgx stdlib adapter, and maps the query results using query result mapping (QRM).
+// Table filters and interceptors from the context are applied to the statements, the same way as for database/sql
+// execution. InterceptedDB interceptors are not available, because pgx connections are not wrapped.
+package pgxqrm
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+)
+
+// Queryable interface for pgx Query method. *pgx.Conn, *pgxpool.Pool and pgx.Tx implement Queryable interface.
+type Queryable interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// Executable interface for pgx Exec method. *pgx.Conn, *pgxpool.Pool and pgx.Tx implement Executable interface.
+type Executable interface {
+	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
+}
+
+// Query executes statement with a context over pgx connection, pool or transaction db and stores row results in destination.
+// Destination can be either pointer to struct or pointer to a slice.
+// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
+func Query(ctx context.Context, db Queryable, statement postgres.Statement, destination interface{}) error {
+	execution := &jet.Execution{
+		Type:        jet.QueryExecution,
+		Destination: destination,
+	}
+
+	return jet.ExecuteContext(ctx, statement, execution, func(ctx context.Context, execution *jet.Execution) error {
+		rows, err := db.Query(ctx, execution.Query, execution.Args...)
+
+		if err != nil {
+			return fmt.Errorf("jet: %w", err)
+		}
+
+		execution.RowsProcessed, err = qrm.MapRows(rowsAdapter{Rows: rows}, execution.Destination)
+
+		return err
+	})
+}
+
+// Exec executes statement with a context over pgx connection, pool or transaction db without returning any rows.
+// If the statement contains optimistic lock version check and no rows are affected, Exec returns postgres.ErrStaleObject.
+// Query, QueryBatchResult and ExecBatchResult return postgres.ErrStaleObject as well, if no rows are processed.
+// Execution Result is not set for the context interceptors, and interceptor short-circuiting the execution
+// results in empty command tag.
+func Exec(ctx context.Context, db Executable, statement postgres.Statement) (pgconn.CommandTag, error) {
+	var commandTag pgconn.CommandTag
+
+	err := jet.ExecuteContext(ctx, statement, &jet.Execution{Type: jet.ExecExecution}, func(ctx context.Context, execution *jet.Execution) (err error) {
+		commandTag, err = db.Exec(ctx, execution.Query, execution.Args...)
+
+		if err == nil {
+			execution.RowsProcessed = commandTag.RowsAffected()
+		}
+
+		return err
+	})
+
+	return commandTag, err
+}
+
+// Rows wraps pgx.Rows type with a support for query result mapping
+type Rows struct {
+	pgx.Rows
+
+	scanContext *qrm.ScanContext
+}
+
+// Scan will map the Row values into struct destination
+func (r *Rows) Scan(destination interface{}) error {
+	return qrm.ScanOneRowToDest(r.scanContext, rowsAdapter{Rows: r.Rows}, destination)
+}
+
+// QueryRows executes statement with a context over pgx connection, pool or transaction db and returns rows.
+// Execution Rows is not set for the context interceptors, and interceptor short-circuiting the execution results in an error.
+func QueryRows(ctx context.Context, db Queryable, statement postgres.Statement) (*Rows, error) {
+	var rows pgx.Rows
+
+	err := jet.ExecuteContext(ctx, statement, &jet.Execution{Type: jet.RowsExecution}, func(ctx context.Context, execution *jet.Execution) (err error) {
+		rows, err = db.Query(ctx, execution.Query, execution.Args...)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == nil {
+		return nil, errors.New("jet: statement execution has been interrupted without rows")
+	}
+
+	scanContext, err := qrm.NewScanContext(rowsAdapter{Rows: rows})
+
+	if err != nil {
+		rows.Close()
+		return nil, err
+	}
+
+	return &Rows{
+		Rows:        rows,
+		scanContext: scanContext,
+	}, nil
+}
+
+// Queue queues statement to the pgx batch. Query results are read, in the same order statements are
+// queued, using QueryBatchResult and ExecBatchResult. Statement is not queued if it can not be serialized.
+func Queue(ctx context.Context, batch *pgx.Batch, statement postgres.Statement) error {
+	query, args, err := jet.BuildContext(ctx, statement)
+
+	if err != nil {
+		return err
+	}
+
+	batch.Queue(query, args...)
+
+	return nil
+}
+
+// QueryBatchResult reads the results of the next queued statement in the batch and stores row results in destination.
+// Destination can be either pointer to struct or pointer to a slice.
+// If destination is pointer to struct and query result set is empty, method returns qrm.ErrNoRows.
+// Statement and query loggers, and context interceptors are called when the result is read. The statement is already
+// sent with the batch, so interceptor query modifications are ignored. If interceptor short-circuits the execution,
+// the result is skipped, so the following results of the batch are still read in order.
+func QueryBatchResult(ctx context.Context, results pgx.BatchResults, statement postgres.Statement, destination interface{}) error {
+	execution := &jet.Execution{
+		Type:        jet.QueryExecution,
+		Destination: destination,
+	}
+
+	resultRead := false
+
+	err := jet.ExecuteContext(ctx, statement, execution, func(ctx context.Context, execution *jet.Execution) error {
+		resultRead = true
+
+		rows, err := results.Query()
+
+		if err != nil {
+			return fmt.Errorf("jet: %w", err)
+		}
+
+		execution.RowsProcessed, err = qrm.MapRows(rowsAdapter{Rows: rows}, execution.Destination)
+
+		return err
+	})
+
+	return skipBatchResult(results, resultRead, err)
+}
+
+// ExecBatchResult reads the results of the next queued statement in the batch, for the statements without
+// returning rows. Loggers and interceptors are called the same way as for QueryBatchResult.
+func ExecBatchResult(ctx context.Context, results pgx.BatchResults, statement postgres.Statement) (pgconn.CommandTag, error) {
+	var commandTag pgconn.CommandTag
+
+	resultRead := false
+
+	err := jet.ExecuteContext(ctx, statement, &jet.Execution{Type: jet.ExecExecution}, func(ctx context.Context, execution *jet.Execution) (err error) {
+		resultRead = true
+
+		commandTag, err = results.Exec()
+
+		if err == nil {
+			execution.RowsProcessed = commandTag.RowsAffected()
+		}
+
+		return err
+	})
+
+	return commandTag, skipBatchResult(results, resultRead, err)
+}
+
+// skipBatchResult reads and discards the batch result, if it has not been read during the statement execution
+func skipBatchResult(results pgx.BatchResults, resultRead bool, err error) error {
+	if resultRead {
+		return err
+	}
+
+	if _, skipErr := results.Exec(); skipErr != nil && err == nil {
+		return fmt.Errorf("jet: %w", skipErr)
+	}
+
+	return err
+}
+
+// rowsAdapter adapts pgx.Rows to qrm.Rows interface
+type rowsAdapter struct {
+	pgx.Rows
+}
+
+func (r rowsAdapter) Columns() ([]string, error) {
+	fieldDescriptions := r.FieldDescriptions()
+	columns := make([]string, 0, len(fieldDescriptions))
+
+	for _, fieldDescription := range fieldDescriptions {
+		columns = append(columns, string(fieldDescription.Name))
+	}
+
+	return columns, nil
+}
+
+// Scan scans the row values into destinations. QRM always scans into the pointers to an empty interface, and for those
+// destinations pgx returns decoded pgtype values. Values implementing driver.Valuer (for instance pgtype.Numeric)
+// are converted into driver values, the same way as database/sql would do.
+func (r rowsAdapter) Scan(dest ...interface{}) error {
+	err := r.Rows.Scan(dest...)
+
+	if err != nil {
+		return err
+	}
+
+	for _, d := range dest {
+		destPtr, ok := d.(*interface{})
+
+		if !ok {
+			continue
+		}
+
+		valuer, ok := (*destPtr).(driver.Valuer)
+
+		if !ok {
+			continue
+		}
+
+		*destPtr, err = valuer.Value()
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r rowsAdapter) Close() error {
+	r.Rows.Close()
+	return nil
+}
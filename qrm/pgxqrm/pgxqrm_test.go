@@ -0,0 +1,380 @@
+package pgxqrm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+	"github.com/go-jet/jet/v2/postgres"
+	"github.com/go-jet/jet/v2/qrm"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgproto3/v2"
+	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeRows struct {
+	pgx.Rows // not implemented methods panic
+
+	columns []string
+	rows    [][]interface{}
+	current int
+	closed  bool
+}
+
+func (f *fakeRows) FieldDescriptions() []pgproto3.FieldDescription {
+	var ret []pgproto3.FieldDescription
+	for _, column := range f.columns {
+		ret = append(ret, pgproto3.FieldDescription{Name: []byte(column)})
+	}
+	return ret
+}
+
+func (f *fakeRows) Next() bool {
+	f.current++
+	return f.current <= len(f.rows)
+}
+
+func (f *fakeRows) Scan(dest ...interface{}) error {
+	for i, value := range f.rows[f.current-1] {
+		*(dest[i].(*interface{})) = value
+	}
+	return nil
+}
+
+func (f *fakeRows) Close() {
+	f.closed = true
+}
+
+func (f *fakeRows) Err() error {
+	return nil
+}
+
+type fakeDB struct {
+	rows       *fakeRows
+	commandTag pgconn.CommandTag
+	err        error
+
+	query string
+	args  []interface{}
+}
+
+func (f *fakeDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	f.query, f.args = sql, args
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.rows, nil
+}
+
+func (f *fakeDB) Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error) {
+	f.query, f.args = sql, arguments
+	if f.commandTag != nil {
+		return f.commandTag, f.err
+	}
+	return pgconn.CommandTag("UPDATE 3"), f.err
+}
+
+var (
+	filmID     = postgres.IntegerColumn("film_id")
+	filmTitle  = postgres.StringColumn("title")
+	filmRating = postgres.FloatColumn("rating")
+	film       = postgres.NewTable("public", "film", "", filmID, filmTitle, filmRating)
+)
+
+type Film struct {
+	FilmID int64 `sql:"primary_key"`
+	Title  string
+	Rating float64
+}
+
+func TestQuery(t *testing.T) {
+	var numeric pgtype.Numeric
+	require.NoError(t, numeric.Set("4.5"))
+
+	db := &fakeDB{
+		rows: &fakeRows{
+			columns: []string{"film.film_id", "film.title", "film.rating"},
+			rows: [][]interface{}{
+				{int64(1), "Academy Dinosaur", &numeric},
+				{int64(2), "Ace Goldfinger", nil},
+			},
+		},
+	}
+
+	var loggedInfo jet.QueryInfo
+	postgres.SetQueryLogger(func(ctx context.Context, info postgres.QueryInfo) {
+		loggedInfo = info
+	})
+	defer postgres.SetQueryLogger(nil)
+
+	stmt := postgres.SELECT(filmID, filmTitle, filmRating).FROM(film).WHERE(filmID.LT(postgres.Int(3)))
+
+	var dest []Film
+	err := Query(context.Background(), db, stmt, &dest)
+	require.NoError(t, err)
+	require.Equal(t, []Film{
+		{FilmID: 1, Title: "Academy Dinosaur", Rating: 4.5},
+		{FilmID: 2, Title: "Ace Goldfinger"},
+	}, dest)
+	require.True(t, db.rows.closed)
+	require.Equal(t, []interface{}{int64(3)}, db.args)
+
+	require.Equal(t, stmt.DebugSql(), loggedInfo.Statement.DebugSql())
+	require.Equal(t, int64(2), loggedInfo.RowsProcessed)
+	require.NoError(t, loggedInfo.Err)
+}
+
+var tenantFilter = postgres.NewTableFilter(
+	[]postgres.Table{film},
+	func(table postgres.Table) postgres.BoolExpression {
+		return postgres.IntegerColumn("tenant_id").EQ(postgres.Int(7))
+	},
+	func(table postgres.Table) []postgres.ColumnAssigment {
+		return []postgres.ColumnAssigment{postgres.IntegerColumn("tenant_id").SET(postgres.Int(7))}
+	},
+)
+
+func TestQueryContextTableFilters(t *testing.T) {
+	db := &fakeDB{rows: &fakeRows{columns: []string{"film.film_id"}}}
+	ctx := postgres.ContextWithTableFilters(context.Background(), tenantFilter)
+
+	var dest []Film
+	err := Query(ctx, db, postgres.SELECT(filmID).FROM(film).WHERE(filmID.LT(postgres.Int(3))), &dest)
+	require.NoError(t, err)
+	require.Equal(t, `
+SELECT film.film_id AS "film.film_id"
+FROM public.film
+WHERE (film.film_id < $1) AND (film.tenant_id = $2);
+`, db.query)
+	require.Equal(t, []interface{}{int64(3), int64(7)}, db.args)
+
+	batch := &pgx.Batch{}
+	require.NoError(t, Queue(ctx, batch, postgres.SELECT(filmID).FROM(film)))
+	require.Equal(t, 1, batch.Len())
+}
+
+func TestExecContextTableFiltersError(t *testing.T) {
+	db := &fakeDB{}
+	ctx := postgres.ContextWithTableFilters(context.Background(), tenantFilter)
+
+	_, err := Exec(ctx, db, film.INSERT(filmID).QUERY(postgres.SELECT(filmID).FROM(film)))
+	require.EqualError(t, err, "jet: table filter assigments can not be applied to INSERT statement with query")
+	require.Empty(t, db.query)
+}
+
+func TestQueryStructNoRows(t *testing.T) {
+	db := &fakeDB{rows: &fakeRows{columns: []string{"film.film_id"}}}
+
+	var dest Film
+	err := Query(context.Background(), db, postgres.SELECT(filmID).FROM(film), &dest)
+	require.ErrorIs(t, err, qrm.ErrNoRows)
+}
+
+func TestQueryError(t *testing.T) {
+	db := &fakeDB{err: errors.New("connection refused")}
+
+	var dest []Film
+	err := Query(context.Background(), db, postgres.SELECT(filmID).FROM(film), &dest)
+	require.EqualError(t, err, "jet: connection refused")
+}
+
+func TestExec(t *testing.T) {
+	db := &fakeDB{}
+
+	var loggedInfo jet.QueryInfo
+	postgres.SetQueryLogger(func(ctx context.Context, info postgres.QueryInfo) {
+		loggedInfo = info
+	})
+	defer postgres.SetQueryLogger(nil)
+
+	stmt := film.UPDATE(filmTitle).SET("Title").WHERE(filmID.GT(postgres.Int(10)))
+
+	commandTag, err := Exec(context.Background(), db, stmt)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), commandTag.RowsAffected())
+	require.Equal(t, `
+UPDATE public.film
+SET title = $1
+WHERE film.film_id > $2;
+`, db.query)
+	require.Equal(t, []interface{}{"Title", int64(10)}, db.args)
+	require.Equal(t, int64(3), loggedInfo.RowsProcessed)
+}
+
+func TestQueryRows(t *testing.T) {
+	db := &fakeDB{
+		rows: &fakeRows{
+			columns: []string{"film.film_id", "film.title"},
+			rows: [][]interface{}{
+				{int64(1), "Academy Dinosaur"},
+				{int64(2), "Ace Goldfinger"},
+			},
+		},
+	}
+
+	rows, err := QueryRows(context.Background(), db, postgres.SELECT(filmID, filmTitle).FROM(film))
+	require.NoError(t, err)
+
+	var films []Film
+
+	for rows.Next() {
+		var film Film
+		require.NoError(t, rows.Scan(&film))
+		films = append(films, film)
+	}
+
+	rows.Close()
+
+	require.Equal(t, []Film{
+		{FilmID: 1, Title: "Academy Dinosaur"},
+		{FilmID: 2, Title: "Ace Goldfinger"},
+	}, films)
+}
+
+type fakeBatchResults struct {
+	pgx.BatchResults // not implemented methods panic
+
+	rows        *fakeRows
+	resultsRead int
+}
+
+func (f *fakeBatchResults) Query() (pgx.Rows, error) {
+	f.resultsRead++
+	return f.rows, nil
+}
+
+func (f *fakeBatchResults) Exec() (pgconn.CommandTag, error) {
+	f.resultsRead++
+	return pgconn.CommandTag("DELETE 1"), nil
+}
+
+func TestBatch(t *testing.T) {
+	var loggedStatements []jet.PrintableStatement
+	postgres.SetLogger(func(ctx context.Context, statement postgres.PrintableStatement) {
+		loggedStatements = append(loggedStatements, statement)
+	})
+	defer postgres.SetLogger(nil)
+
+	var loggedInfos []jet.QueryInfo
+	postgres.SetQueryLogger(func(ctx context.Context, info postgres.QueryInfo) {
+		loggedInfos = append(loggedInfos, info)
+	})
+	defer postgres.SetQueryLogger(nil)
+
+	ctx := context.Background()
+	selectStmt := postgres.SELECT(filmID, filmTitle).FROM(film)
+	deleteStmt := film.DELETE().WHERE(filmID.EQ(postgres.Int(1)))
+
+	batch := &pgx.Batch{}
+	require.NoError(t, Queue(ctx, batch, selectStmt))
+	require.NoError(t, Queue(ctx, batch, deleteStmt))
+
+	require.Equal(t, 2, batch.Len())
+	require.Empty(t, loggedStatements)
+
+	results := &fakeBatchResults{
+		rows: &fakeRows{
+			columns: []string{"film.film_id", "film.title"},
+			rows:    [][]interface{}{{int64(1), "Academy Dinosaur"}},
+		},
+	}
+
+	var dest []Film
+	require.NoError(t, QueryBatchResult(ctx, results, selectStmt, &dest))
+	require.Equal(t, []Film{{FilmID: 1, Title: "Academy Dinosaur"}}, dest)
+
+	commandTag, err := ExecBatchResult(ctx, results, deleteStmt)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), commandTag.RowsAffected())
+
+	require.Len(t, loggedStatements, 2)
+	require.Equal(t, selectStmt.DebugSql(), loggedStatements[0].DebugSql())
+	require.Equal(t, deleteStmt.DebugSql(), loggedStatements[1].DebugSql())
+
+	require.Len(t, loggedInfos, 2)
+	require.Equal(t, selectStmt.DebugSql(), loggedInfos[0].Statement.DebugSql())
+	require.Equal(t, int64(1), loggedInfos[0].RowsProcessed)
+	require.Equal(t, deleteStmt.DebugSql(), loggedInfos[1].Statement.DebugSql())
+	require.Equal(t, int64(1), loggedInfos[1].RowsProcessed)
+}
+
+func TestContextInterceptors(t *testing.T) {
+	db := &fakeDB{}
+
+	var executions []jet.Execution
+
+	ctx := postgres.ContextWithInterceptors(context.Background(), func(ctx context.Context, execution *postgres.Execution, next postgres.ExecutionHandler) error {
+		execution.Query = "/* traced */" + execution.Query
+		err := next(ctx, execution)
+		executions = append(executions, *execution)
+		return err
+	})
+
+	commandTag, err := Exec(ctx, db, film.DELETE().WHERE(filmID.EQ(postgres.Int(1))))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), commandTag.RowsAffected())
+	require.Equal(t, `/* traced */
+DELETE FROM public.film
+WHERE film.film_id = $1;
+`, db.query)
+
+	require.Len(t, executions, 1)
+	require.Equal(t, jet.ExecExecution, executions[0].Type)
+	require.Equal(t, []interface{}{int64(1)}, executions[0].Args)
+	require.Equal(t, int64(3), executions[0].RowsProcessed)
+}
+
+func TestBatchResultInterceptorShortCircuit(t *testing.T) {
+	ctx := postgres.ContextWithInterceptors(context.Background(), func(ctx context.Context, execution *postgres.Execution, next postgres.ExecutionHandler) error {
+		return nil
+	})
+
+	results := &fakeBatchResults{}
+
+	commandTag, err := ExecBatchResult(ctx, results, film.DELETE().WHERE(filmID.EQ(postgres.Int(1))))
+	require.NoError(t, err)
+	require.Empty(t, commandTag)
+	// short-circuited result is skipped, so the next result is read in order
+	require.Equal(t, 1, results.resultsRead)
+}
+
+var (
+	versionedID      = postgres.IntegerColumn("id")
+	versionedVersion = postgres.IntegerColumn("version")
+	versioned        = postgres.NewTableWithOptions("public", "versioned", "", postgres.TableOptions{VersionColumn: versionedVersion},
+		versionedID, versionedVersion)
+)
+
+type Versioned struct {
+	ID      int64
+	Version int64
+}
+
+func TestExecStaleObject(t *testing.T) {
+	stmt := versioned.UPDATE(versionedID).
+		MODEL(Versioned{ID: 1, Version: 3}).
+		WHERE(versionedID.EQ(postgres.Int(1)))
+
+	_, err := Exec(context.Background(), &fakeDB{commandTag: pgconn.CommandTag("UPDATE 1")}, stmt)
+	require.NoError(t, err)
+
+	_, err = Exec(context.Background(), &fakeDB{commandTag: pgconn.CommandTag("UPDATE 0")}, stmt)
+	require.ErrorIs(t, err, postgres.ErrStaleObject)
+
+	_, err = ExecBatchResult(context.Background(), &fakeBatchResults{}, stmt)
+	require.NoError(t, err)
+}
+
+func TestQueryStaleObject(t *testing.T) {
+	stmt := versioned.UPDATE(versionedID).
+		MODEL(Versioned{ID: 1, Version: 3}).
+		WHERE(versionedID.EQ(postgres.Int(1))).
+		RETURNING(versionedVersion)
+
+	var dest []Versioned
+	err := Query(context.Background(), &fakeDB{rows: &fakeRows{columns: []string{"versioned.version"}}}, stmt, &dest)
+	require.ErrorIs(t, err, postgres.ErrStaleObject)
+}
@@ -2,7 +2,6 @@ package qrm
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/utils/must"
@@ -19,13 +18,39 @@ var ErrNoRows = errors.New("qrm: no rows in result set")
 func Query(ctx context.Context, db Queryable, query string, args []interface{}, destPtr interface{}) (rowsProcessed int64, err error) {
 
 	must.BeInitializedPtr(db, "jet: db is nil")
+
+	return mapToDestination(destPtr, func(slicePtr interface{}, destType reflect.Type) (int64, error) {
+		return queryToSlice(ctx, db, query, args, slicePtr, destType)
+	})
+}
+
+// MapRows executes Query Result Mapping (QRM) of all the `rows` into destination `destPtr`. Rows are closed afterwards.
+// MapRows can be used to map result sets of database drivers not compatible with database/sql.
+// Destination can be either pointer to struct or pointer to slice of structs.
+// If destination is pointer to struct and result set is empty, method returns qrm.ErrNoRows.
+func MapRows(rows Rows, destPtr interface{}) (rowsProcessed int64, err error) {
+
+	must.BeInitializedPtr(rows, "jet: rows is nil")
+
+	return mapToDestination(destPtr, func(slicePtr interface{}, destType reflect.Type) (int64, error) {
+		defer rows.Close()
+
+		return rowsToSlice(rows, slicePtr, destType)
+	})
+}
+
+func mapToDestination(
+	destPtr interface{},
+	mapToSlice func(slicePtr interface{}, destType reflect.Type) (int64, error),
+) (rowsProcessed int64, err error) {
+
 	must.BeInitializedPtr(destPtr, "jet: destination is nil")
 	must.BeTypeKind(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
 
 	destinationPtrType := reflect.TypeOf(destPtr)
 
 	if destinationPtrType.Elem().Kind() == reflect.Slice {
-		rowsProcessed, err := queryToSlice(ctx, db, query, args, destPtr)
+		rowsProcessed, err := mapToSlice(destPtr, destinationPtrType.Elem())
 		if err != nil {
 			return rowsProcessed, fmt.Errorf("jet: %w", err)
 		}
@@ -34,7 +59,7 @@ func Query(ctx context.Context, db Queryable, query string, args []interface{},
 		tempSlicePtrValue := reflect.New(reflect.SliceOf(destinationPtrType))
 		tempSliceValue := tempSlicePtrValue.Elem()
 
-		rowsProcessed, err := queryToSlice(ctx, db, query, args, tempSlicePtrValue.Interface())
+		rowsProcessed, err := mapToSlice(tempSlicePtrValue.Interface(), destinationPtrType.Elem())
 
 		if err != nil {
 			return rowsProcessed, fmt.Errorf("jet: %w", err)
@@ -62,7 +87,7 @@ func Query(ctx context.Context, db Queryable, query string, args []interface{},
 }
 
 // ScanOneRowToDest will scan one row into struct destination
-func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interface{}) error {
+func ScanOneRowToDest(scanContext *ScanContext, rows Rows, destPtr interface{}) error {
 	must.BeInitializedPtr(destPtr, "jet: destination is nil")
 	must.BeTypeKind(destPtr, reflect.Ptr, "jet: destination has to be a pointer to slice or pointer to struct")
 
@@ -76,7 +101,10 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 		return fmt.Errorf("jet: rows scan error, %w", err)
 	}
 
+	scanContext.rowNum++
+
 	destValuePtr := reflect.ValueOf(destPtr)
+	scanContext.destPath = newDestPath(destValuePtr.Type().Elem().String(), false)
 
 	_, err = mapRowToStruct(scanContext, "", destValuePtr, nil)
 
@@ -87,7 +115,14 @@ func ScanOneRowToDest(scanContext *ScanContext, rows *sql.Rows, destPtr interfac
 	return nil
 }
 
-func queryToSlice(ctx context.Context, db Queryable, query string, args []interface{}, slicePtr interface{}) (rowsProcessed int64, err error) {
+func queryToSlice(
+	ctx context.Context,
+	db Queryable,
+	query string,
+	args []interface{},
+	slicePtr interface{},
+	destType reflect.Type, // used for error reporting
+) (rowsProcessed int64, err error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -99,6 +134,10 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 	}
 	defer rows.Close()
 
+	return rowsToSlice(rows, slicePtr, destType)
+}
+
+func rowsToSlice(rows Rows, slicePtr interface{}, destType reflect.Type) (rowsProcessed int64, err error) {
 	scanContext, err := NewScanContext(rows)
 
 	if err != nil {
@@ -109,6 +148,8 @@ func queryToSlice(ctx context.Context, db Queryable, query string, args []interf
 		return
 	}
 
+	scanContext.destPath = newDestPath(destType.String(), destType.Kind() == reflect.Struct)
+
 	slicePtrValue := reflect.ValueOf(slicePtr)
 
 	for rows.Next() {
@@ -159,12 +200,17 @@ func mapRowToSlice(
 	if ok {
 		structPtrValue := getSliceElemPtrAt(slicePtrValue, index)
 
+		scanContext.destPath.pushIndex(index)
+		defer scanContext.destPath.pop()
+
 		return mapRowToStruct(scanContext, groupKey, structPtrValue, field, true)
 	}
 
 	destinationStructPtr := newElemPtrValueForSlice(slicePtrValue)
 
+	scanContext.destPath.pushIndex(slicePtrValue.Elem().Len())
 	updated, err = mapRowToStruct(scanContext, groupKey, destinationStructPtr, field)
+	scanContext.destPath.pop()
 
 	if err != nil {
 		return
@@ -194,9 +240,14 @@ func mapRowToBaseTypeSlice(scanContext *ScanContext, slicePtrValue reflect.Value
 
 	if rowElemPtr.IsValid() && !rowElemPtr.IsNil() {
 		updated = true
+		sliceLen := slicePtrValue.Elem().Len()
 		err = appendElemToSlice(slicePtrValue, rowElemPtr)
 		if err != nil {
-			return
+			scanContext.destPath.pushIndex(sliceLen)
+			defer scanContext.destPath.pop()
+
+			return updated, scanContext.newMappingError(appendError, index, rowElemPtr.Elem().Interface(), "",
+				slicePtrValue.Elem().Type().Elem(), errors.Unwrap(err))
 		}
 	}
 
@@ -237,7 +288,9 @@ func mapRowToStruct(
 
 		if fieldMap.complexType {
 			var changed bool
+			scanContext.destPath.pushField(field.Name)
 			changed, err = mapRowToDestinationValue(scanContext, concat(groupKey, ":", field.Name), fieldValue, &field)
+			scanContext.destPath.pop()
 
 			if err != nil {
 				return
@@ -270,14 +323,14 @@ func mapRowToStruct(
 				err := fieldScanner.Scan(value)
 
 				if err != nil {
-					return updated, fmt.Errorf(`can't scan %T(%q) to '%s %s': %w`, value, value, field.Name, field.Type.String(), err)
+					return updated, scanContext.newMappingError(scanError, fieldMap.rowIndex, value, field.Name, field.Type, err)
 				}
 			} else {
 				err := assign(scannedValue, fieldValue)
 
 				if err != nil {
-					return updated, fmt.Errorf(`can't assign %T(%q) to '%s %s': %w`, scannedValue.Interface(), scannedValue.Interface(),
-						field.Name, field.Type.String(), err)
+					return updated, scanContext.newMappingError(assignError, fieldMap.rowIndex, scannedValue.Interface(),
+						field.Name, field.Type, err)
 				}
 			}
 		}
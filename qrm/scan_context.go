@@ -1,8 +1,8 @@
 package qrm
 
 import (
-	"database/sql"
 	"fmt"
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
 	"reflect"
 	"strings"
 )
@@ -12,43 +12,33 @@ import (
 type ScanContext struct {
 	rowNum                   int64
 	row                      []interface{}
+	columnAliases            []string
 	uniqueDestObjectsMap     map[string]int
 	commonIdentToColumnIndex map[string]int
 	groupKeyInfoCache        map[string]groupKeyInfo
 	typeInfoMap              map[string]typeInfo
 
 	typesVisited typeStack // to prevent circular dependency scan
+	destPath     destPath  // used only for error reporting
 }
 
 // NewScanContext creates new ScanContext from rows
-func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
+func NewScanContext(rows Rows) (*ScanContext, error) {
 	aliases, err := rows.Columns()
 
 	if err != nil {
 		return nil, err
 	}
 
-	columnTypes, err := rows.ColumnTypes()
-
-	if err != nil {
-		return nil, err
-	}
-
 	commonIdentToColumnIndex := map[string]int{}
 
 	for i, alias := range aliases {
-		names := strings.SplitN(alias, ".", 2)
-		commonIdentifier := toCommonIdentifier(names[0])
-
-		if len(names) > 1 {
-			commonIdentifier = concat(commonIdentifier, ".", toCommonIdentifier(names[1]))
-		}
-
-		commonIdentToColumnIndex[commonIdentifier] = i
+		commonIdentToColumnIndex[aliasToCommonIdentifier(alias)] = i
 	}
 
 	return &ScanContext{
-		row:                  createScanSlice(len(columnTypes)),
+		row:                  createScanSlice(len(aliases)),
+		columnAliases:        aliases,
 		uniqueDestObjectsMap: make(map[string]int),
 
 		groupKeyInfoCache:        make(map[string]groupKeyInfo),
@@ -60,6 +50,27 @@ func NewScanContext(rows *sql.Rows) (*ScanContext, error) {
 	}, nil
 }
 
+// aliasToCommonIdentifier converts projection alias ('table.column' or 'column') into common identifier
+func aliasToCommonIdentifier(alias string) string {
+	names := strings.SplitN(alias, ".", 2)
+	commonIdentifier := toCommonIdentifier(names[0])
+
+	if len(names) > 1 {
+		commonIdentifier = concat(commonIdentifier, ".", toCommonIdentifier(names[1]))
+	}
+
+	return commonIdentifier
+}
+
+// typeAndFieldToCommonIdentifier returns common identifier of the destination type field, used to find matching projection alias
+func typeAndFieldToCommonIdentifier(typeName, fieldName string) string {
+	if typeName != "" {
+		return strings.ToLower(typeName + "." + fieldName)
+	}
+
+	return strings.ToLower(fieldName)
+}
+
 func createScanSlice(columnCount int) []interface{} {
 	scanPtrSlice := make([]interface{}, columnCount)
 
@@ -107,7 +118,7 @@ func (s *ScanContext) getTypeInfo(structType reflect.Type, parentField *reflect.
 			rowIndex: columnIndex,
 		}
 
-		if implementsScannerType(field.Type) {
+		if implementsScannerType(field.Type) && !typeconv.HasFromDriver(indirectType(field.Type)) {
 			fieldMap.implementsScanner = true
 		} else if !isSimpleModelType(field.Type) {
 			fieldMap.complexType = true
@@ -180,14 +191,22 @@ func (s *ScanContext) getGroupKeyInfo(
 	typeVisited.push(&structType)
 	defer typeVisited.pop()
 
+	strategy := getGroupingStrategy(structType)
+
+	if strategy == NoGrouping {
+		return ret // every row creates a new object
+	}
+
 	typeName := getTypeName(structType, parentField)
 	primaryKeyOverwrites := parentFieldPrimaryKeyOverwrite(parentField)
+	useGroupKeyTag := strategy == GroupByGroupKey ||
+		(strategy == GroupByPrimaryKey && len(primaryKeyOverwrites) == 0 && !hasPrimaryKeyField(structType))
 
 	for i := 0; i < structType.NumField(); i++ {
 		field := structType.Field(i)
 		fieldType := indirectType(field.Type)
 
-		if isPrimaryKey(field, primaryKeyOverwrites) {
+		if isGroupKeyField(field, strategy, primaryKeyOverwrites, useGroupKeyTag) {
 			newTypeName, fieldName := getTypeAndFieldName(typeName, field)
 
 			pkIndex := s.typeToColumnIndex(newTypeName, fieldName)
@@ -198,7 +217,7 @@ func (s *ScanContext) getGroupKeyInfo(
 
 			ret.pkIndexes = append(ret.pkIndexes, pkIndex)
 
-		} else if fieldType.Kind() == reflect.Struct && fieldType != timeType {
+		} else if fieldType.Kind() == reflect.Struct && !isSimpleModelType(fieldType) {
 
 			subType := s.getGroupKeyInfo(fieldType, &field, typeVisited)
 
@@ -212,15 +231,7 @@ func (s *ScanContext) getGroupKeyInfo(
 }
 
 func (s *ScanContext) typeToColumnIndex(typeName, fieldName string) int {
-	var key string
-
-	if typeName != "" {
-		key = strings.ToLower(typeName + "." + fieldName)
-	} else {
-		key = strings.ToLower(fieldName)
-	}
-
-	index, ok := s.commonIdentToColumnIndex[key]
+	index, ok := s.commonIdentToColumnIndex[typeAndFieldToCommonIdentifier(typeName, fieldName)]
 
 	if !ok {
 		return -1
@@ -229,6 +240,14 @@ func (s *ScanContext) typeToColumnIndex(typeName, fieldName string) int {
 	return index
 }
 
+func (s *ScanContext) columnAlias(index int) string {
+	if index < 0 || index >= len(s.columnAliases) {
+		return ""
+	}
+
+	return s.columnAliases[index]
+}
+
 // rowElemValue always returns non-ptr value,
 // invalid value is nil
 func (s *ScanContext) rowElemValue(index int) reflect.Value {
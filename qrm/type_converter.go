@@ -0,0 +1,65 @@
+package qrm
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
+)
+
+// RegisterTypeConverter registers conversion functions for the Go type T, usually a third-party type
+// which does not implement sql.Scanner or driver.Valuer (for instance decimal.Decimal, netip.Addr or custom money types).
+// fromDriver is used by query result mapping to convert scanned database value into destination field of type T (or *T).
+// toDriver is used by SQL builder to convert literal values of type T (for instance from INSERT MODEL or VALUES)
+// into query arguments. Either of the functions can be nil, in which case default conversion is used for that direction.
+// Converters are usually registered once, during application initialization.
+func RegisterTypeConverter[T any](fromDriver func(value interface{}) (T, error), toDriver func(value T) (driver.Value, error)) {
+	converter := typeconv.Converter{}
+
+	if fromDriver != nil {
+		converter.FromDriver = func(value interface{}) (reflect.Value, error) {
+			ret, err := fromDriver(value)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			return reflect.ValueOf(&ret).Elem(), nil
+		}
+	}
+
+	if toDriver != nil {
+		converter.ToDriver = func(value interface{}) (driver.Value, error) {
+			return toDriver(value.(T))
+		}
+	}
+
+	typeconv.Register(reflect.TypeOf((*T)(nil)).Elem(), converter)
+}
+
+// UnregisterTypeConverter removes conversion functions registered for the Go type T
+func UnregisterTypeConverter[T any]() {
+	typeconv.Unregister(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+// source and destination are non-ptr values
+func tryConvertWithRegisteredConverter(source, destination reflect.Value) (converted bool, err error) {
+	converter, ok := typeconv.Lookup(destination.Type())
+
+	if !ok || converter.FromDriver == nil {
+		return false, nil
+	}
+
+	value, err := converter.FromDriver(source.Interface())
+
+	if err != nil {
+		return true, err
+	}
+
+	if !value.Type().AssignableTo(destination.Type()) {
+		return true, fmt.Errorf("type converter returned %s instead of %s", value.Type(), destination.Type())
+	}
+
+	destination.Set(value)
+
+	return true, nil
+}
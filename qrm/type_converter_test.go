@@ -0,0 +1,66 @@
+package qrm
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type money struct {
+	cents int64
+}
+
+func moneyFromDriver(value interface{}) (money, error) {
+	str, ok := value.(string)
+
+	if !ok {
+		return money{}, errors.New("money value is not a string")
+	}
+
+	cents, err := strconv.ParseInt(strings.Replace(str, ".", "", 1), 10, 64)
+
+	return money{cents: cents}, err
+}
+
+func TestRegisterTypeConverter(t *testing.T) {
+	RegisterTypeConverter(moneyFromDriver, nil)
+	defer UnregisterTypeConverter[money]()
+
+	require.True(t, isSimpleModelType(reflect.TypeOf(money{})))
+	require.True(t, isSimpleModelType(reflect.TypeOf(&money{})))
+
+	type Account struct {
+		ID       int64 `sql:"primary_key"`
+		Balance  money
+		Reserved *money
+	}
+
+	scanContext := newTestScanContext([]string{"account.id", "account.balance", "account.reserved"},
+		int64(1), "10.11", "2.00")
+
+	var dest []Account
+	scanContext.destPath = newDestPath(reflect.TypeOf(dest).String(), false)
+
+	_, err := mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.NoError(t, err)
+	require.Len(t, dest, 1)
+	require.Equal(t, money{cents: 1011}, dest[0].Balance)
+	require.Equal(t, money{cents: 200}, *dest[0].Reserved)
+
+	var moneySlice []money
+
+	_, err = mapRowToSlice(newTestScanContext([]string{"balance"}, "3.30"), "", reflect.ValueOf(&moneySlice), nil)
+	require.NoError(t, err)
+	require.Equal(t, []money{{cents: 330}}, moneySlice)
+
+	scanContext = newTestScanContext([]string{"account.id", "account.balance"}, int64(2), int64(11))
+	scanContext.destPath = newDestPath(reflect.TypeOf(dest).String(), false)
+	dest = nil
+
+	_, err = mapRowToSlice(scanContext, "", reflect.ValueOf(&dest), nil)
+	require.EqualError(t, err, `can't assign int64('\v') to 'Balance qrm.money' (column "account.balance", row 1, path []qrm.Account[0].Balance): money value is not a string`)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/go-jet/jet/v2/internal/utils/must"
 	"github.com/go-jet/jet/v2/internal/utils/strslice"
+	"github.com/go-jet/jet/v2/internal/utils/typeconv"
 	"github.com/go-jet/jet/v2/qrm/internal"
 	"github.com/google/uuid"
 	"reflect"
@@ -155,7 +156,7 @@ func isSimpleModelType(objType reflect.Type) bool {
 		return true
 	}
 
-	return objType == timeType || objType == uuidType || objType == byteArrayType
+	return objType == timeType || objType == uuidType || objType == byteArrayType || typeconv.HasFromDriver(objType)
 }
 
 // source can't be pointer
@@ -169,6 +170,10 @@ func assign(source, destination reflect.Value) error {
 		destination = destination.Elem()
 	}
 
+	if converted, err := tryConvertWithRegisteredConverter(source, destination); converted {
+		return err
+	}
+
 	err := tryAssign(source, destination)
 
 	if err != nil {
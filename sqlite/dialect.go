@@ -57,7 +57,7 @@ func sqlite_IS_NOT_DISTINCT_FROM(expressions ...jet.Serializer) jet.SerializerFu
 		}
 
 		jet.Serialize(expressions[0], statement, out)
-		out.WriteString("IS")
+		out.WriteKeyword("IS")
 		jet.Serialize(expressions[1], statement, out)
 	}
 }
@@ -69,7 +69,7 @@ func sqlite_IS_DISTINCT_FROM(expressions ...jet.Serializer) jet.SerializerFunc {
 		}
 
 		jet.Serialize(expressions[0], statement, out)
-		out.WriteString("IS NOT")
+		out.WriteKeyword("IS NOT")
 		jet.Serialize(expressions[1], statement, out)
 	}
 }
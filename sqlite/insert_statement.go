@@ -4,7 +4,7 @@ import "github.com/go-jet/jet/v2/internal/jet"
 
 // InsertStatement is interface for SQL INSERT statements
 type InsertStatement interface {
-	Statement
+	ConfigurableStatement
 
 	VALUES(value interface{}, values ...interface{}) InsertStatement
 	MODEL(data interface{}) InsertStatement
@@ -14,6 +14,10 @@ type InsertStatement interface {
 
 	ON_CONFLICT(indexExpressions ...jet.ColumnExpression) onConflict
 	RETURNING(projections ...Projection) InsertStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() InsertStatement
 }
 
 func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
@@ -21,19 +25,34 @@ func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
 		DefaultValues: jet.ClauseOptional{Name: "DEFAULT VALUES", InNewLine: true},
 	}
 
-	newInsert.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, newInsert,
-		&newInsert.Insert,
-		&newInsert.ValuesQuery,
-		&newInsert.DefaultValues,
-		&newInsert.OnConflict,
-		&newInsert.Returning,
-	)
-
 	newInsert.Insert.Table = table
 	newInsert.Insert.Columns = columns
 	newInsert.ValuesQuery.SkipSelectWrap = true
 
-	return newInsert
+	return newInsert.init()
+}
+
+func (is *insertStatementImpl) init() *insertStatementImpl {
+	is.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, is,
+		&is.Insert,
+		&is.ValuesQuery,
+		&is.DefaultValues,
+		&is.OnConflict,
+		&is.Returning,
+	)
+
+	if is.OnConflict.insertStatement != nil {
+		is.OnConflict.insertStatement = is
+	}
+
+	return is
+}
+
+func (is *insertStatementImpl) Clone() InsertStatement {
+	newInsert := *is
+	jet.CloneSlices(&newInsert)
+
+	return newInsert.init()
 }
 
 type insertStatementImpl struct {
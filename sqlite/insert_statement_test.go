@@ -148,3 +148,22 @@ RETURNING table1.col1 AS "table1.col1",
           table1.col_bool AS "table1.col_bool";
 `)
 }
+
+func TestInsertClone(t *testing.T) {
+	base := table1.INSERT(table1ColInt, table1ColFloat).
+		VALUES(1, 2.2)
+
+	withConflict := base.Clone().VALUES(3, 4.4)
+	withConflict.ON_CONFLICT(table1ColInt).DO_NOTHING()
+
+	assertStatementSql(t, withConflict.Clone(), `
+INSERT INTO db.table1 (col_int, col_float)
+VALUES (?, ?),
+       (?, ?)
+ON CONFLICT (col_int) DO NOTHING;
+`, 1, 2.2, 3, 4.4)
+	assertStatementSql(t, base, `
+INSERT INTO db.table1 (col_int, col_float)
+VALUES (?, ?);
+`, 1, 2.2)
+}
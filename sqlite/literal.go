@@ -44,7 +44,7 @@ var Uint64 = jet.Uint64
 // Float creates new float literal expression from float64 value
 var Float = jet.Float
 
-// Decimal creates new float literal expression from string value
+// Decimal creates new decimal literal expression from string value
 var Decimal = jet.Decimal
 
 // String creates new string literal expression
@@ -68,3 +68,59 @@ func Time(hour, minute, second int, nanoseconds ...time.Duration) TimeExpression
 func DateTime(year int, month time.Month, day, hour, minute, second int, nanoseconds ...time.Duration) DateTimeExpression {
 	return DATETIME(jet.Timestamp(year, month, day, hour, minute, second, nanoseconds...))
 }
+
+// Param creates new named parameter expression of the type T, which value has to be set at statement execution time,
+// using statement WithArgs method or prepared statement named arguments. T has to be one of the expression interfaces,
+// and parameter can be used wherever expression of the type T is expected, for instance Param[IntegerExpression]("limit").
+// Named parameters are serialized the same way as the literals of the same type.
+func Param[T Expression](name string) T {
+	var param Expression
+
+	switch any((*T)(nil)).(type) {
+	case *DateExpression:
+		param = DATE(jet.DateParam(name))
+	case *TimeExpression:
+		param = TIME(jet.TimeParam(name))
+	case *DateTimeExpression:
+		param = DATETIME(jet.TimestampParam(name))
+	default:
+		return jet.Param[T](name)
+	}
+
+	return param.(T)
+}
+
+// BoolParam creates new named parameter bool expression
+func BoolParam(name string) BoolExpression {
+	return Param[BoolExpression](name)
+}
+
+// IntParam creates new named parameter integer expression
+func IntParam(name string) IntegerExpression {
+	return Param[IntegerExpression](name)
+}
+
+// FloatParam creates new named parameter float expression
+func FloatParam(name string) FloatExpression {
+	return Param[FloatExpression](name)
+}
+
+// StringParam creates new named parameter string expression
+func StringParam(name string) StringExpression {
+	return Param[StringExpression](name)
+}
+
+// DateParam creates new named parameter date expression
+func DateParam(name string) DateExpression {
+	return Param[DateExpression](name)
+}
+
+// TimeParam creates new named parameter time expression
+func TimeParam(name string) TimeExpression {
+	return Param[TimeExpression](name)
+}
+
+// DateTimeParam creates new named parameter datetime(timestamp) expression
+func DateTimeParam(name string) DateTimeExpression {
+	return Param[DateTimeExpression](name)
+}
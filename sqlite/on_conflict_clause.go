@@ -37,13 +37,17 @@ func (o *onConflictClause) DO_UPDATE(action conflictAction) InsertStatement {
 	return o.insertStatement
 }
 
+func (o *onConflictClause) ClauseName() string {
+	return "ON CONFLICT"
+}
+
 func (o *onConflictClause) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
 	if is.Nil(o.do) {
 		return
 	}
 
 	out.NewLine()
-	out.WriteString("ON CONFLICT")
+	out.WriteKeyword("ON CONFLICT")
 	if len(o.indexExpressions) > 0 {
 		out.WriteString("(")
 		jet.SerializeColumnExpressions(o.indexExpressions, statementType, out, jet.ShortName)
@@ -68,6 +72,7 @@ func SET(assigments ...ColumnAssigment) conflictAction {
 	conflictAction.doUpdate = jet.KeywordClause{Keyword: "DO UPDATE"}
 	conflictAction.Serializer = jet.NewSerializerClauseImpl(&conflictAction.doUpdate, &conflictAction.set, &conflictAction.where)
 	conflictAction.set = assigments
+	conflictAction.where.ConflictAction = true
 	return &conflictAction
 }
 
@@ -36,7 +36,7 @@ var Window = jet.WindowName
 
 // SelectStatement is interface for MySQL SELECT statement
 type SelectStatement interface {
-	Statement
+	ConfigurableStatement
 	jet.HasProjections
 	Expression
 
@@ -47,7 +47,14 @@ type SelectStatement interface {
 	HAVING(boolExpression BoolExpression) SelectStatement
 	WINDOW(name string) windowExpand
 	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
+	// SEEK sets ORDER BY clause and adds keyset pagination condition to the WHERE clause, selecting the rows ordered
+	// after the row with the last-seen values of the order by expressions. Without last values, first page is selected.
+	// EncodeCursor and DecodeCursor can be used to pass the last values between the requests.
+	SEEK(orderBy []OrderByClause, lastValues ...interface{}) SelectStatement
 	LIMIT(limit int64) SelectStatement
+	// LIMIT_e can be used when an integer expression is needed as limit, for instance named parameter,
+	// otherwise LIMIT can be used
+	LIMIT_e(limit IntegerExpression) SelectStatement
 	OFFSET(offset int64) SelectStatement
 	FOR(lock RowLock) SelectStatement
 	LOCK_IN_SHARE_MODE() SelectStatement
@@ -56,6 +63,10 @@ type SelectStatement interface {
 	UNION_ALL(rhs SelectStatement) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() SelectStatement
 }
 
 // SELECT creates new SelectStatement with list of projections
@@ -65,10 +76,6 @@ func SELECT(projection Projection, projections ...Projection) SelectStatement {
 
 func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
 	newSelect := &selectStatementImpl{}
-	newSelect.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, newSelect, &newSelect.Select,
-		&newSelect.From, &newSelect.Where, &newSelect.GroupBy, &newSelect.Having, &newSelect.Window, &newSelect.OrderBy,
-		&newSelect.Limit, &newSelect.Offset, &newSelect.For, &newSelect.ShareLock)
-
 	newSelect.Select.ProjectionList = projections
 	if table != nil {
 		newSelect.From.Tables = []jet.Serializer{table}
@@ -77,9 +84,24 @@ func newSelectStatement(table ReadableTable, projections []Projection) SelectSta
 	newSelect.ShareLock.Name = "LOCK IN SHARE MODE"
 	newSelect.ShareLock.InNewLine = true
 
-	newSelect.setOperatorsImpl.parent = newSelect
+	return newSelect.init()
+}
+
+func (s *selectStatementImpl) init() *selectStatementImpl {
+	s.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, s, &s.Select,
+		&s.From, &s.Where, &s.GroupBy, &s.Having, &s.Window, &s.OrderBy,
+		&s.Limit, &s.Offset, &s.For, &s.ShareLock)
+
+	s.setOperatorsImpl.parent = s
+
+	return s
+}
+
+func (s *selectStatementImpl) Clone() SelectStatement {
+	newSelect := *s
+	jet.CloneSlices(&newSelect)
 
-	return newSelect
+	return newSelect.init()
 }
 
 type selectStatementImpl struct {
@@ -134,8 +156,24 @@ func (s *selectStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectSt
 	return s
 }
 
+func (s *selectStatementImpl) SEEK(orderBy []OrderByClause, lastValues ...interface{}) SelectStatement {
+	s.OrderBy.List = orderBy
+	s.Where.Keyset = nil
+
+	if len(lastValues) > 0 {
+		s.Where.Keyset = jet.KeysetCondition(orderBy, lastValues)
+	}
+
+	return s
+}
+
 func (s *selectStatementImpl) LIMIT(limit int64) SelectStatement {
-	s.Limit.Count = limit
+	s.Limit = jet.ClauseLimit{Count: limit}
+	return s
+}
+
+func (s *selectStatementImpl) LIMIT_e(limit IntegerExpression) SelectStatement {
+	s.Limit = jet.ClauseLimit{Count: -1, CountExpression: limit}
 	return s
 }
 
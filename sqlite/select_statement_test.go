@@ -110,6 +110,16 @@ FROM db.table2
 LIMIT ?
 OFFSET ?;
 `, int64(10), int64(2))
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_e(IntParam("limit")).WithArgs(map[string]interface{}{"limit": 5}), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT ?;
+`, 5)
+	assertStatementSql(t, SELECT(table2ColInt).FROM(table2).LIMIT_e(Int(3)).LIMIT(10), `
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+LIMIT ?;
+`, int64(10))
 }
 
 func TestSelectLock(t *testing.T) {
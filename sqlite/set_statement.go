@@ -20,13 +20,20 @@ type setStatement interface {
 	ORDER_BY(orderByClauses ...OrderByClause) setStatement
 
 	LIMIT(limit int64) setStatement
+	// LIMIT_e can be used when an integer expression is needed as limit, for instance named parameter,
+	// otherwise LIMIT can be used
+	LIMIT_e(limit IntegerExpression) setStatement
 	OFFSET(offset int64) setStatement
 
 	AsTable(alias string) SelectTable
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() setStatement
 }
 
 type setOperators interface {
-	jet.Statement
+	jet.ConfigurableStatement
 	jet.HasProjections
 	jet.Expression
 
@@ -56,18 +63,28 @@ type setStatementImpl struct {
 
 func newSetStatementImpl(operator string, all bool, selects []jet.SerializerStatement) setStatement {
 	newSetStatement := &setStatementImpl{}
-	newSetStatement.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SetStatementType, newSetStatement,
-		&newSetStatement.setOperator)
-
 	newSetStatement.setOperator.Operator = operator
 	newSetStatement.setOperator.All = all
 	newSetStatement.setOperator.Selects = selects
 	newSetStatement.setOperator.Limit.Count = -1
 	newSetStatement.setOperator.SkipSelectWrap = true
 
-	newSetStatement.setOperatorsImpl.parent = newSetStatement
+	return newSetStatement.init()
+}
+
+func (s *setStatementImpl) init() *setStatementImpl {
+	s.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SetStatementType, s, &s.setOperator)
+
+	s.setOperatorsImpl.parent = s
+
+	return s
+}
+
+func (s *setStatementImpl) Clone() setStatement {
+	newSetStatement := *s
+	jet.CloneSlices(&newSetStatement)
 
-	return newSetStatement
+	return newSetStatement.init()
 }
 
 func (s *setStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) setStatement {
@@ -76,7 +93,12 @@ func (s *setStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) setStatemen
 }
 
 func (s *setStatementImpl) LIMIT(limit int64) setStatement {
-	s.setOperator.Limit.Count = limit
+	s.setOperator.Limit = jet.ClauseLimit{Count: limit}
+	return s
+}
+
+func (s *setStatementImpl) LIMIT_e(limit IntegerExpression) setStatement {
+	s.setOperator.Limit = jet.ClauseLimit{Count: -1, CountExpression: limit}
 	return s
 }
 
@@ -3,6 +3,6 @@ package sqlite
 import "github.com/go-jet/jet/v2/internal/jet"
 
 // RawStatement creates new sql statements from raw query and optional map of named arguments
-func RawStatement(rawQuery string, namedArguments ...RawArgs) Statement {
+func RawStatement(rawQuery string, namedArguments ...RawArgs) ConfigurableStatement {
 	return jet.RawStatement(Dialect, rawQuery, namedArguments...)
 }
@@ -77,8 +77,27 @@ func (r readableTableInterfaceImpl) CROSS_JOIN(table ReadableTable) joinSelectUp
 
 // NewTable creates new table with schema Name, table Name and list of columns
 func NewTable(schemaName, name, alias string, columns ...jet.ColumnExpression) Table {
+	return newTable(jet.NewTable(schemaName, name, alias, columns...))
+}
+
+// NewSoftDeleteTable creates new soft delete table with schema Name, table Name, soft delete column and list of columns.
+// Statements exclude the rows with soft delete column set, and DELETE statement sets soft delete column
+// to the current timestamp instead of deleting the rows, unless the statement is Unscoped.
+func NewSoftDeleteTable(schemaName, name, alias string, softDeleteColumn jet.ColumnExpression, columns ...jet.ColumnExpression) Table {
+	return newTable(jet.NewSoftDeleteTable(schemaName, name, alias, softDeleteColumn, columns...))
+}
+
+// TableOptions are optional table behaviours, soft delete column and optimistic lock version column
+type TableOptions = jet.TableOptions
+
+// NewTableWithOptions creates new table with schema Name, table Name, table options and list of columns
+func NewTableWithOptions(schemaName, name, alias string, options TableOptions, columns ...jet.ColumnExpression) Table {
+	return newTable(jet.NewTableWithOptions(schemaName, name, alias, options, columns...))
+}
+
+func newTable(table jet.SerializerTable) Table {
 	t := &tableImpl{
-		SerializerTable: jet.NewTable(schemaName, name, alias, columns...),
+		SerializerTable: table,
 	}
 
 	t.readableTableInterfaceImpl.parent = t
@@ -4,7 +4,7 @@ import "github.com/go-jet/jet/v2/internal/jet"
 
 // UpdateStatement is interface of SQL UPDATE statement
 type UpdateStatement interface {
-	jet.Statement
+	jet.ConfigurableStatement
 
 	SET(value interface{}, values ...interface{}) UpdateStatement
 	MODEL(data interface{}) UpdateStatement
@@ -12,6 +12,10 @@ type UpdateStatement interface {
 	FROM(tables ...ReadableTable) UpdateStatement
 	WHERE(expression BoolExpression) UpdateStatement
 	RETURNING(projections ...Projection) UpdateStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() UpdateStatement
 }
 
 type updateStatementImpl struct {
@@ -27,19 +31,30 @@ type updateStatementImpl struct {
 
 func newUpdateStatement(table Table, columns []jet.Column) UpdateStatement {
 	update := &updateStatementImpl{}
-	update.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, update,
-		&update.Update,
-		&update.Set,
-		&update.SetNew,
-		&update.From,
-		&update.Where,
-		&update.Returning)
-
 	update.Update.Table = table
 	update.Set.Columns = columns
 	update.Where.Mandatory = true
 
-	return update
+	return update.init()
+}
+
+func (u *updateStatementImpl) init() *updateStatementImpl {
+	u.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, u,
+		&u.Update,
+		&u.Set,
+		&u.SetNew,
+		&u.From,
+		&u.Where,
+		&u.Returning)
+
+	return u
+}
+
+func (u *updateStatementImpl) Clone() UpdateStatement {
+	newUpdate := *u
+	jet.CloneSlices(&newUpdate)
+
+	return newUpdate.init()
 }
 
 func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) UpdateStatement {
@@ -58,7 +73,7 @@ func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) Upda
 }
 
 func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
-	u.Set.Values = jet.UnwindRowFromModel(u.Set.Columns, data)
+	u.Set.Columns, u.Set.Values, u.Where.VersionCheck = jet.UnwindVersionedRowFromModel(u.Update.Table, u.Set.Columns, data)
 	return u
 }
 
@@ -52,4 +52,5 @@ func assertProjectionSerialize(t *testing.T, projection jet.Projection, query st
 
 var assertPanicErr = testutils.AssertPanicErr
 var assertStatementSql = testutils.AssertStatementSql
+var assertDebugStatementSql = testutils.AssertDebugStatementSql
 var assertStatementSqlErr = testutils.AssertStatementSqlErr
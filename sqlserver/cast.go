@@ -0,0 +1,101 @@
+package sqlserver
+
+import (
+	"strconv"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+type cast interface {
+	AS(castType string) Expression
+	AS_BIT() BoolExpression
+	AS_INT() IntegerExpression
+	AS_BIGINT() IntegerExpression
+	AS_DECIMAL(precision, scale int) DecimalExpression
+	AS_FLOAT() FloatExpression
+	AS_VARCHAR(length ...int) StringExpression
+	AS_NVARCHAR(length ...int) StringExpression
+	AS_DATE() DateExpression
+	AS_TIME() TimeExpression
+	AS_DATETIME2() DateTimeExpression
+	AS_UNIQUEIDENTIFIER() StringExpression
+}
+
+type castImpl struct {
+	jet.Cast
+}
+
+// CAST function converts a expr (of any type) into latter specified datatype.
+func CAST(expr Expression) cast {
+	castImpl := &castImpl{}
+	castImpl.Cast = jet.NewCastImpl(expr)
+	return castImpl
+}
+
+// AS casts expressions to castType
+func (c *castImpl) AS(castType string) Expression {
+	return c.Cast.AS(castType)
+}
+
+// AS_BIT cast expression to BIT type
+func (c *castImpl) AS_BIT() BoolExpression {
+	return BoolExp(c.AS("BIT"))
+}
+
+// AS_INT cast expression to INT type
+func (c *castImpl) AS_INT() IntegerExpression {
+	return IntExp(c.AS("INT"))
+}
+
+// AS_BIGINT cast expression to BIGINT type
+func (c *castImpl) AS_BIGINT() IntegerExpression {
+	return IntExp(c.AS("BIGINT"))
+}
+
+// AS_DECIMAL cast expression to DECIMAL type with precision and scale
+func (c *castImpl) AS_DECIMAL(precision, scale int) DecimalExpression {
+	return DecimalExp(c.AS("DECIMAL(" + strconv.Itoa(precision) + ", " + strconv.Itoa(scale) + ")"))
+}
+
+// AS_FLOAT cast expression to FLOAT type
+func (c *castImpl) AS_FLOAT() FloatExpression {
+	return FloatExp(c.AS("FLOAT"))
+}
+
+// AS_VARCHAR cast expression to VARCHAR type with optional length. Without length, VARCHAR(MAX) is used.
+func (c *castImpl) AS_VARCHAR(length ...int) StringExpression {
+	return StringExp(c.AS("VARCHAR" + castLength(length)))
+}
+
+// AS_NVARCHAR cast expression to NVARCHAR type with optional length. Without length, NVARCHAR(MAX) is used.
+func (c *castImpl) AS_NVARCHAR(length ...int) StringExpression {
+	return StringExp(c.AS("NVARCHAR" + castLength(length)))
+}
+
+// AS_DATE cast expression to DATE type
+func (c *castImpl) AS_DATE() DateExpression {
+	return DateExp(c.AS("DATE"))
+}
+
+// AS_TIME cast expression to TIME type
+func (c *castImpl) AS_TIME() TimeExpression {
+	return TimeExp(c.AS("TIME"))
+}
+
+// AS_DATETIME2 cast expression to DATETIME2 type
+func (c *castImpl) AS_DATETIME2() DateTimeExpression {
+	return DateTimeExp(c.AS("DATETIME2"))
+}
+
+// AS_UNIQUEIDENTIFIER cast expression to UNIQUEIDENTIFIER type
+func (c *castImpl) AS_UNIQUEIDENTIFIER() StringExpression {
+	return StringExp(c.AS("UNIQUEIDENTIFIER"))
+}
+
+func castLength(length []int) string {
+	if len(length) == 0 {
+		return "(MAX)"
+	}
+
+	return "(" + strconv.Itoa(length[0]) + ")"
+}
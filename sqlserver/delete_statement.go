@@ -0,0 +1,60 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// DeleteStatement is interface for SQL Server DELETE statement
+type DeleteStatement interface {
+	ConfigurableStatement
+
+	// OUTPUT returns the deleted rows projections. Projection columns are qualified with DELETED pseudo table.
+	OUTPUT(projections ...Projection) DeleteStatement
+	WHERE(expression BoolExpression) DeleteStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() DeleteStatement
+}
+
+type deleteStatementImpl struct {
+	jet.SerializerStatement
+
+	Delete jet.ClauseDelete
+	Output jet.ClauseOutput
+	Where  jet.ClauseWhere
+}
+
+func newDeleteStatement(table Table) DeleteStatement {
+	newDelete := &deleteStatementImpl{}
+	newDelete.Delete.Table = table
+	newDelete.Output.Qualifier = "DELETED"
+	newDelete.Where.Mandatory = true
+
+	return newDelete.init()
+}
+
+func (d *deleteStatementImpl) init() *deleteStatementImpl {
+	d.SerializerStatement = jet.NewStatementImpl(Dialect, jet.DeleteStatementType, d,
+		&d.Delete,
+		&d.Output,
+		&d.Where,
+	)
+
+	return d
+}
+
+func (d *deleteStatementImpl) Clone() DeleteStatement {
+	newDelete := *d
+	jet.CloneSlices(&newDelete)
+
+	return newDelete.init()
+}
+
+func (d *deleteStatementImpl) OUTPUT(projections ...jet.Projection) DeleteStatement {
+	d.Output.ProjectionList = projections
+	return d
+}
+
+func (d *deleteStatementImpl) WHERE(expression BoolExpression) DeleteStatement {
+	d.Where.Condition = expression
+	return d
+}
@@ -0,0 +1,36 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestDeleteUnconditionally(t *testing.T) {
+	assertStatementSqlErr(t, table1.DELETE(), `jet: WHERE clause not set`)
+}
+
+func TestDeleteOutput(t *testing.T) {
+	stmt := table3.DELETE().
+		OUTPUT(ColumnList{table3Col1, table3ColInt, table3StrCol}).
+		WHERE(table3ColInt.EQ(Int(2)))
+
+	assertStatementSql(t, stmt, `
+DELETE FROM db.table3
+OUTPUT DELETED.col1 AS "table3.col1",
+          DELETED.col_int AS "table3.col_int",
+          DELETED.col2 AS "table3.col2"
+WHERE table3.col_int = @p1;
+`, int64(2))
+}
+
+func TestSoftDeleteTable(t *testing.T) {
+	deletedAt := TimestampColumn("deleted_at")
+	idColumn := IntegerColumn("id")
+	softDeleteTable := NewSoftDeleteTable("dbo", "items", "", deletedAt, idColumn, deletedAt)
+
+	assertStatementSql(t, softDeleteTable.DELETE().OUTPUT(idColumn).WHERE(idColumn.EQ(Int(1))), `
+UPDATE dbo.items
+SET deleted_at = CURRENT_TIMESTAMP
+OUTPUT DELETED.id AS "items.id"
+WHERE (items.id = @p1) AND items.deleted_at IS NULL;
+`, int64(1))
+}
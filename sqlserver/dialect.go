@@ -0,0 +1,272 @@
+package sqlserver
+
+import (
+	"strconv"
+
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Dialect is implementation of SQL Builder for SQL Server databases.
+var Dialect = newDialect()
+
+func newDialect() jet.Dialect {
+	operatorSerializeOverrides := map[string]jet.SerializeOverride{}
+	operatorSerializeOverrides[jet.StringConcatOperator] = sqlServerCONCAToperator
+	operatorSerializeOverrides["#"] = sqlServerBitXOR
+
+	sqlServerDialectParams := jet.DialectParams{
+		Name:                       "SQLServer",
+		PackageName:                "sqlserver",
+		OperatorSerializeOverrides: operatorSerializeOverrides,
+		AliasQuoteChar:             '"',
+		IdentifierQuoteChar:        '[',
+		IdentifierCloseQuoteChar:   ']',
+		ArgumentPlaceholder: func(ord int) string {
+			return "@p" + strconv.Itoa(ord)
+		},
+		ReservedWords:            reservedWords,
+		SerializeOrderBy:         serializeOrderBy,
+		ExpandRowValueComparison: true,
+	}
+
+	return jet.NewDialect(sqlServerDialectParams)
+}
+
+func sqlServerCONCAToperator(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator CONCAT")
+		}
+
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString("+")
+		jet.Serialize(expressions[1], statement, out, options...)
+	}
+}
+
+func sqlServerBitXOR(expressions ...jet.Serializer) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if len(expressions) < 2 {
+			panic("jet: invalid number of expressions for operator XOR")
+		}
+
+		jet.Serialize(expressions[0], statement, out, options...)
+		out.WriteString("^")
+		jet.Serialize(expressions[1], statement, out, options...)
+	}
+}
+
+// SQL Server does not support NULLS FIRST and NULLS LAST, so null ordering is emulated with the CASE expression
+func serializeOrderBy(expression Expression, ascending, nullsFirst *bool) jet.SerializerFunc {
+	return func(statement jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+		if nullsFirst != nil {
+			nullsOrder := CASE().WHEN(expression.IS_NULL()).THEN(Int(0)).ELSE(Int(1))
+
+			if !*nullsFirst {
+				nullsOrder = CASE().WHEN(expression.IS_NULL()).THEN(Int(1)).ELSE(Int(0))
+			}
+
+			jet.SerializeForOrderBy(nullsOrder, statement, out)
+			out.WriteString(", ")
+		}
+
+		jet.SerializeForOrderBy(expression, statement, out)
+
+		if ascending == nil {
+			return
+		}
+
+		if *ascending {
+			out.WriteKeyword("ASC")
+		} else {
+			out.WriteKeyword("DESC")
+		}
+	}
+}
+
+var reservedWords = []string{
+	"ADD",
+	"ALL",
+	"ALTER",
+	"AND",
+	"ANY",
+	"AS",
+	"ASC",
+	"AUTHORIZATION",
+	"BACKUP",
+	"BEGIN",
+	"BETWEEN",
+	"BREAK",
+	"BROWSE",
+	"BULK",
+	"BY",
+	"CASCADE",
+	"CASE",
+	"CHECK",
+	"CHECKPOINT",
+	"CLOSE",
+	"CLUSTERED",
+	"COALESCE",
+	"COLLATE",
+	"COLUMN",
+	"COMMIT",
+	"COMPUTE",
+	"CONSTRAINT",
+	"CONTAINS",
+	"CONTAINSTABLE",
+	"CONTINUE",
+	"CONVERT",
+	"CREATE",
+	"CROSS",
+	"CURRENT",
+	"CURRENT_DATE",
+	"CURRENT_TIME",
+	"CURRENT_TIMESTAMP",
+	"CURRENT_USER",
+	"CURSOR",
+	"DATABASE",
+	"DBCC",
+	"DEALLOCATE",
+	"DECLARE",
+	"DEFAULT",
+	"DELETE",
+	"DENY",
+	"DESC",
+	"DISK",
+	"DISTINCT",
+	"DISTRIBUTED",
+	"DOUBLE",
+	"DROP",
+	"DUMP",
+	"ELSE",
+	"END",
+	"ERRLVL",
+	"ESCAPE",
+	"EXCEPT",
+	"EXEC",
+	"EXECUTE",
+	"EXISTS",
+	"EXIT",
+	"EXTERNAL",
+	"FETCH",
+	"FILE",
+	"FILLFACTOR",
+	"FOR",
+	"FOREIGN",
+	"FREETEXT",
+	"FREETEXTTABLE",
+	"FROM",
+	"FULL",
+	"FUNCTION",
+	"GOTO",
+	"GRANT",
+	"GROUP",
+	"HAVING",
+	"HOLDLOCK",
+	"IDENTITY",
+	"IDENTITY_INSERT",
+	"IDENTITYCOL",
+	"IF",
+	"IN",
+	"INDEX",
+	"INNER",
+	"INSERT",
+	"INTERSECT",
+	"INTO",
+	"IS",
+	"JOIN",
+	"KEY",
+	"KILL",
+	"LEFT",
+	"LIKE",
+	"LINENO",
+	"LOAD",
+	"MERGE",
+	"NATIONAL",
+	"NOCHECK",
+	"NONCLUSTERED",
+	"NOT",
+	"NULL",
+	"NULLIF",
+	"OF",
+	"OFF",
+	"OFFSETS",
+	"ON",
+	"OPEN",
+	"OPENDATASOURCE",
+	"OPENQUERY",
+	"OPENROWSET",
+	"OPENXML",
+	"OPTION",
+	"OR",
+	"ORDER",
+	"OUTER",
+	"OVER",
+	"PERCENT",
+	"PIVOT",
+	"PLAN",
+	"PRECISION",
+	"PRIMARY",
+	"PRINT",
+	"PROC",
+	"PROCEDURE",
+	"PUBLIC",
+	"RAISERROR",
+	"READ",
+	"READTEXT",
+	"RECONFIGURE",
+	"REFERENCES",
+	"REPLICATION",
+	"RESTORE",
+	"RESTRICT",
+	"RETURN",
+	"REVERT",
+	"REVOKE",
+	"RIGHT",
+	"ROLLBACK",
+	"ROWCOUNT",
+	"ROWGUIDCOL",
+	"RULE",
+	"SAVE",
+	"SCHEMA",
+	"SECURITYAUDIT",
+	"SELECT",
+	"SEMANTICKEYPHRASETABLE",
+	"SEMANTICSIMILARITYDETAILSTABLE",
+	"SEMANTICSIMILARITYTABLE",
+	"SESSION_USER",
+	"SET",
+	"SETUSER",
+	"SHUTDOWN",
+	"SOME",
+	"STATISTICS",
+	"SYSTEM_USER",
+	"TABLE",
+	"TABLESAMPLE",
+	"TEXTSIZE",
+	"THEN",
+	"TO",
+	"TOP",
+	"TRAN",
+	"TRANSACTION",
+	"TRIGGER",
+	"TRUNCATE",
+	"TRY_CONVERT",
+	"TSEQUAL",
+	"UNION",
+	"UNIQUE",
+	"UNPIVOT",
+	"UPDATE",
+	"UPDATETEXT",
+	"USE",
+	"USER",
+	"VALUES",
+	"VARYING",
+	"VIEW",
+	"WAITFOR",
+	"WHEN",
+	"WHERE",
+	"WHILE",
+	"WITH",
+	"WRITETEXT",
+}
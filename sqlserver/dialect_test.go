@@ -0,0 +1,69 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestIdentifierQuote(t *testing.T) {
+	userColumn := IntegerColumn("User")
+	_ = NewTable("dbo", "Order", "", userColumn)
+
+	assertSerialize(t, userColumn, "[Order].[User]")
+
+	escapedColumn := IntegerColumn("col]umn")
+	_ = NewTable("dbo", "[Order]", "", escapedColumn)
+
+	assertSerialize(t, escapedColumn, "[[Order]]].[col]]umn]")
+}
+
+func TestBool(t *testing.T) {
+	assertSerialize(t, Bool(true), "CAST(1 AS BIT)")
+	assertSerialize(t, Bool(false), "CAST(0 AS BIT)")
+	assertSerialize(t, table1ColBool.EQ(Bool(true)), "(table1.col_bool = CAST(1 AS BIT))")
+}
+
+func TestArgumentPlaceholder(t *testing.T) {
+	assertStatementSql(t, SELECT(Int(1), String("str")).FROM(table1).WHERE(table1ColInt.EQ(Int(2))), `
+SELECT @p1,
+     @p2
+FROM db.table1
+WHERE table1.col_int = @p3;
+`, int64(1), "str", int64(2))
+}
+
+func TestStringConcat(t *testing.T) {
+	assertSerialize(t, table1ColString.CONCAT(table2ColStr), "(table1.col_string + table2.col_str)")
+	assertSerialize(t, table1ColString.CONCAT(String("str")), "(table1.col_string + @p1)", "str")
+}
+
+func TestIntExpressionBIT_XOR(t *testing.T) {
+	assertSerialize(t, table1ColInt.BIT_XOR(table2ColInt), "(table1.col_int ^ table2.col_int)")
+}
+
+func TestOrderByNulls(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt.ASC().NULLS_LAST(), table1ColFloat.DESC().NULLS_FIRST()), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY (CASE WHEN table1.col_int IS NULL THEN @p1 ELSE @p2 END), table1.col_int ASC, (CASE WHEN table1.col_float IS NULL THEN @p3 ELSE @p4 END), table1.col_float DESC;
+`, int64(1), int64(0), int64(0), int64(1))
+}
+
+func TestFunctions(t *testing.T) {
+	assertSerialize(t, ISNULL(table1ColInt, Int(0)), "ISNULL(table1.col_int, @p1)", int64(0))
+	assertSerialize(t, IIF(table1ColBool, String("yes"), String("no")), "IIF(table1.col_bool, @p1, @p2)", "yes", "no")
+	assertSerialize(t, LEN(table1ColString), "LEN(table1.col_string)")
+	assertSerialize(t, CHARINDEX(String("a"), table1ColString, Int(2)), "CHARINDEX(@p1, table1.col_string, @p2)", "a", int64(2))
+	assertSerialize(t, GETDATE(), "GETDATE()")
+	assertSerialize(t, SYSDATETIME(), "SYSDATETIME()")
+	assertSerialize(t, NEWID(), "NEWID()")
+	assertSerialize(t, DATEADD(DAY, Int(1), table1ColTimestamp), "DATEADD(DAY, @p1, table1.col_timestamp)", int64(1))
+	assertSerialize(t, DATEDIFF(HOUR, table1ColTimestamp, GETDATE()), "DATEDIFF(HOUR, table1.col_timestamp, GETDATE())")
+	assertSerialize(t, DATEPART(YEAR, table1ColDate), "DATEPART(YEAR, table1.col_date)")
+}
+
+func TestCast(t *testing.T) {
+	assertSerialize(t, CAST(table1ColInt).AS_NVARCHAR(), "CAST(table1.col_int AS NVARCHAR(MAX))")
+	assertSerialize(t, CAST(table1ColInt).AS_VARCHAR(20), "CAST(table1.col_int AS VARCHAR(20))")
+	assertSerialize(t, CAST(table1ColString).AS_DECIMAL(10, 2), "CAST(table1.col_string AS DECIMAL(10, 2))")
+	assertDebugSerialize(t, Date(2020, 1, 2), "CAST('2020-01-02' AS DATE)")
+}
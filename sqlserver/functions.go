@@ -0,0 +1,299 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// This functions can be used, instead of its method counterparts, to have a better indentation of a complex condition
+// in the Go code and in the generated SQL.
+var (
+	// AND function adds AND operator between expressions.
+	AND = jet.AND
+	// OR function adds OR operator between expressions.
+	OR = jet.OR
+)
+
+// ------------------ Mathematical functions ---------------//
+
+// ABSf calculates absolute value from float expression
+var ABSf = jet.ABSf
+
+// ABSi calculates absolute value from int expression
+var ABSi = jet.ABSi
+
+// POWER calculates power of base with exponent
+var POWER = jet.POWER
+
+// SQRT calculates square root of numeric expression
+var SQRT = jet.SQRT
+
+// CEILING calculates ceil of float expression
+func CEILING(floatExpression FloatExpression) FloatExpression {
+	return jet.NewFloatFunc("CEILING", floatExpression)
+}
+
+// FLOOR calculates floor of float expression
+var FLOOR = jet.FLOOR
+
+// ROUND calculates round of a float expressions with optional precision
+func ROUND(floatExpression FloatExpression, precision ...IntegerExpression) FloatExpression {
+	if len(precision) > 0 {
+		return jet.NewFloatFunc("ROUND", floatExpression, precision[0])
+	}
+
+	return jet.NewFloatFunc("ROUND", floatExpression, Int(0))
+}
+
+// SIGN returns sign of float expression
+var SIGN = jet.SIGN
+
+// LOG calculates natural logarithm of float expression
+func LOG(floatExpression FloatExpression) FloatExpression {
+	return jet.NewFloatFunc("LOG", floatExpression)
+}
+
+// LOG10 calculates base 10 logarithm of float expression
+func LOG10(floatExpression FloatExpression) FloatExpression {
+	return jet.NewFloatFunc("LOG10", floatExpression)
+}
+
+// ----------------- Aggregate functions  -------------------//
+
+// AVG is aggregate function used to calculate avg value from numeric expression
+var AVG = jet.AVG
+
+// COUNT is aggregate function. Returns number of input rows for which the value of expression is not null.
+var COUNT = jet.COUNT
+
+// COUNT_BIG is aggregate function. Returns number of input rows as BIGINT.
+func COUNT_BIG(expression Expression) IntegerExpression {
+	return IntExp(jet.Func("COUNT_BIG", expression))
+}
+
+// MAX is aggregate function. Returns maximum value of expression across all input values
+var MAX = jet.MAX
+
+// MAXi is aggregate function. Returns maximum value of int expression across all input values
+var MAXi = jet.MAXi
+
+// MAXf is aggregate function. Returns maximum value of float expression across all input values
+var MAXf = jet.MAXf
+
+// MIN is aggregate function. Returns minimum value of int expression across all input values
+var MIN = jet.MIN
+
+// MINi is aggregate function. Returns minimum value of int expression across all input values
+var MINi = jet.MINi
+
+// MINf is aggregate function. Returns minimum value of float expression across all input values
+var MINf = jet.MINf
+
+// SUM is aggregate function. Returns sum of all expressions
+var SUM = jet.SUM
+
+// SUMi is aggregate function. Returns sum of integer expression.
+var SUMi = jet.SUMi
+
+// SUMf is aggregate function. Returns sum of float expression.
+var SUMf = jet.SUMf
+
+// -------------------- Window functions -----------------------//
+
+// ROW_NUMBER returns number of the current row within its partition, counting from 1
+var ROW_NUMBER = jet.ROW_NUMBER
+
+// RANK of the current row with gaps; same as row_number of its first peer
+var RANK = jet.RANK
+
+// DENSE_RANK returns rank of the current row without gaps; this function counts peer groups
+var DENSE_RANK = jet.DENSE_RANK
+
+// PERCENT_RANK calculates relative rank of the current row: (rank - 1) / (total partition rows - 1)
+var PERCENT_RANK = jet.PERCENT_RANK
+
+// CUME_DIST calculates cumulative distribution: (number of partition rows preceding or peer with current row) / total partition rows
+var CUME_DIST = jet.CUME_DIST
+
+// NTILE returns integer ranging from 1 to the argument value, dividing the partition as equally as possible
+var NTILE = jet.NTILE
+
+// LAG returns value evaluated at the row that is offset rows before the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+var LAG = jet.LAG
+
+// LEAD returns value evaluated at the row that is offset rows after the current row within the partition;
+// if there is no such row, instead return default (which must be of the same type as value).
+var LEAD = jet.LEAD
+
+// FIRST_VALUE returns value evaluated at the row that is the first row of the window frame
+var FIRST_VALUE = jet.FIRST_VALUE
+
+// LAST_VALUE returns value evaluated at the row that is the last row of the window frame
+var LAST_VALUE = jet.LAST_VALUE
+
+//--------------------- String functions ------------------//
+
+// LOWER returns string expression in lower case
+var LOWER = jet.LOWER
+
+// UPPER returns string expression in upper case
+var UPPER = jet.UPPER
+
+// LTRIM removes leading spaces from the string
+func LTRIM(str StringExpression) StringExpression {
+	return jet.LTRIM(str)
+}
+
+// RTRIM removes trailing spaces from the string
+func RTRIM(str StringExpression) StringExpression {
+	return jet.RTRIM(str)
+}
+
+// TRIM removes leading and trailing spaces from the string
+func TRIM(str StringExpression) StringExpression {
+	return jet.NewStringFunc("TRIM", str)
+}
+
+// CONCAT adds two or more expressions together
+var CONCAT = jet.CONCAT
+
+// CONCAT_WS adds two or more expressions together with a separator.
+var CONCAT_WS = jet.CONCAT_WS
+
+// LEFT returns first n characters in the string.
+var LEFT = jet.LEFT
+
+// RIGHT returns last n characters in the string.
+var RIGHT = jet.RIGHT
+
+// LEN returns number of characters in the string, excluding trailing spaces
+func LEN(str StringExpression) IntegerExpression {
+	return IntExp(jet.Func("LEN", str))
+}
+
+// DATALENGTH returns number of bytes used to represent the expression
+func DATALENGTH(expression Expression) IntegerExpression {
+	return IntExp(jet.Func("DATALENGTH", expression))
+}
+
+// CHARINDEX returns starting position of the substring in the string, searching from optional start position.
+// If substring is not found, 0 is returned.
+func CHARINDEX(substring, str StringExpression, start ...IntegerExpression) IntegerExpression {
+	if len(start) > 0 {
+		return IntExp(jet.Func("CHARINDEX", substring, str, start[0]))
+	}
+
+	return IntExp(jet.Func("CHARINDEX", substring, str))
+}
+
+// REPLACE replaces all occurrences in string of substring from with substring to
+var REPLACE = jet.REPLACE
+
+// REVERSE returns reversed string.
+var REVERSE = jet.REVERSE
+
+// REPLICATE repeats string the specified number of times
+func REPLICATE(str StringExpression, count IntegerExpression) StringExpression {
+	return jet.NewStringFunc("REPLICATE", str, count)
+}
+
+// SUBSTRING extracts length characters from the string, starting at start position
+func SUBSTRING(str StringExpression, start, length IntegerExpression) StringExpression {
+	return jet.NewStringFunc("SUBSTRING", str, start, length)
+}
+
+//----------------- Date/Time Functions and Operators ------------//
+
+// CURRENT_TIMESTAMP returns current database system timestamp as a datetime value
+func CURRENT_TIMESTAMP() DateTimeExpression {
+	return DateTimeExp(jet.CURRENT_TIMESTAMP())
+}
+
+// GETDATE returns current database system timestamp as a datetime value
+func GETDATE() DateTimeExpression {
+	return jet.NewTimestampFunc("GETDATE")
+}
+
+// GETUTCDATE returns current database system UTC timestamp as a datetime value
+func GETUTCDATE() DateTimeExpression {
+	return jet.NewTimestampFunc("GETUTCDATE")
+}
+
+// SYSDATETIME returns current database system timestamp as a datetime2 value
+func SYSDATETIME() DateTimeExpression {
+	return jet.NewTimestampFunc("SYSDATETIME")
+}
+
+// DatePart is a part of the date or time value, used by DATEADD, DATEDIFF and DATEPART functions
+type DatePart string
+
+// List of date parts
+const (
+	YEAR        DatePart = "YEAR"
+	QUARTER     DatePart = "QUARTER"
+	MONTH       DatePart = "MONTH"
+	DAYOFYEAR   DatePart = "DAYOFYEAR"
+	DAY         DatePart = "DAY"
+	WEEK        DatePart = "WEEK"
+	WEEKDAY     DatePart = "WEEKDAY"
+	HOUR        DatePart = "HOUR"
+	MINUTE      DatePart = "MINUTE"
+	SECOND      DatePart = "SECOND"
+	MILLISECOND DatePart = "MILLISECOND"
+	MICROSECOND DatePart = "MICROSECOND"
+	NANOSECOND  DatePart = "NANOSECOND"
+)
+
+// DATEADD adds number of date parts to the date or time value
+func DATEADD(datePart DatePart, number IntegerExpression, date Expression) DateTimeExpression {
+	return DateTimeExp(datePartFunc("DATEADD", datePart, number, date))
+}
+
+// DATEDIFF returns the number of date part boundaries crossed between the start date and end date
+func DATEDIFF(datePart DatePart, startDate, endDate Expression) IntegerExpression {
+	return IntExp(datePartFunc("DATEDIFF", datePart, startDate, endDate))
+}
+
+// DATEPART returns date part of the date or time value as integer
+func DATEPART(datePart DatePart, date Expression) IntegerExpression {
+	return IntExp(datePartFunc("DATEPART", datePart, date))
+}
+
+func datePartFunc(name string, datePart DatePart, expressions ...Expression) Expression {
+	tokens := []jet.Serializer{jet.Token(name + "("), jet.Token(datePart)}
+
+	for _, expression := range expressions {
+		tokens = append(tokens, jet.Token(", "), expression)
+	}
+
+	return CustomExpression(append(tokens, jet.Token(")"))...)
+}
+
+// --------------- Conditional Expressions Functions -------------//
+
+// COALESCE function returns the first of its arguments that is not null.
+var COALESCE = jet.COALESCE
+
+// NULLIF function returns a null value if value1 equals value2; otherwise it returns value1.
+var NULLIF = jet.NULLIF
+
+// ISNULL function returns replacement value if the expression is null; otherwise it returns expression.
+func ISNULL(expression, replacement Expression) Expression {
+	return jet.Func("ISNULL", expression, replacement)
+}
+
+// IIF function returns trueValue if the condition is true; otherwise it returns falseValue.
+func IIF(condition BoolExpression, trueValue, falseValue Expression) Expression {
+	return jet.Func("IIF", condition, trueValue, falseValue)
+}
+
+// EXISTS checks for existence of the rows in subQuery
+var EXISTS = jet.EXISTS
+
+// CASE create CASE operator with optional list of expressions
+var CASE = jet.CASE
+
+// ------------------ Other functions ---------------//
+
+// NEWID creates new unique value of uniqueidentifier type
+func NEWID() StringExpression {
+	return jet.NewStringFunc("NEWID")
+}
@@ -0,0 +1,93 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// InsertStatement is interface for SQL INSERT statements
+type InsertStatement interface {
+	ConfigurableStatement
+
+	VALUES(value interface{}, values ...interface{}) InsertStatement
+	MODEL(data interface{}) InsertStatement
+	MODELS(data interface{}) InsertStatement
+	QUERY(selectStatement SelectStatement) InsertStatement
+	DEFAULT_VALUES() InsertStatement
+
+	// OUTPUT returns the inserted rows projections. Projection columns are qualified with INSERTED pseudo table.
+	OUTPUT(projections ...Projection) InsertStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() InsertStatement
+}
+
+func newInsertStatement(table Table, columns []jet.Column) InsertStatement {
+	newInsert := &insertStatementImpl{
+		DefaultValues: jet.ClauseOptional{Name: "DEFAULT VALUES", InNewLine: true},
+	}
+
+	newInsert.Insert.Table = table
+	newInsert.Insert.Columns = columns
+	newInsert.Output.Qualifier = "INSERTED"
+	newInsert.ValuesQuery.SkipSelectWrap = true
+
+	return newInsert.init()
+}
+
+func (is *insertStatementImpl) init() *insertStatementImpl {
+	is.SerializerStatement = jet.NewStatementImpl(Dialect, jet.InsertStatementType, is,
+		&is.Insert,
+		&is.Output,
+		&is.ValuesQuery,
+		&is.DefaultValues,
+	)
+
+	return is
+}
+
+func (is *insertStatementImpl) Clone() InsertStatement {
+	newInsert := *is
+	jet.CloneSlices(&newInsert)
+
+	return newInsert.init()
+}
+
+type insertStatementImpl struct {
+	jet.SerializerStatement
+
+	Insert        jet.ClauseInsert
+	Output        jet.ClauseOutput
+	ValuesQuery   jet.ClauseValuesQuery
+	DefaultValues jet.ClauseOptional
+}
+
+func (is *insertStatementImpl) VALUES(value interface{}, values ...interface{}) InsertStatement {
+	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowFromValues(value, values))
+	return is
+}
+
+// MODEL will insert row of values, where value for each column is extracted from filed of structure data.
+// If data is not struct or there is no field for every column selected, this method will panic.
+func (is *insertStatementImpl) MODEL(data interface{}) InsertStatement {
+	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowFromModel(is.Insert.GetColumns(), data))
+	return is
+}
+
+func (is *insertStatementImpl) MODELS(data interface{}) InsertStatement {
+	is.ValuesQuery.Rows = append(is.ValuesQuery.Rows, jet.UnwindRowsFromModels(is.Insert.GetColumns(), data)...)
+	return is
+}
+
+func (is *insertStatementImpl) QUERY(selectStatement SelectStatement) InsertStatement {
+	is.ValuesQuery.Query = selectStatement
+	return is
+}
+
+func (is *insertStatementImpl) DEFAULT_VALUES() InsertStatement {
+	is.DefaultValues.Show = true
+	return is
+}
+
+func (is *insertStatementImpl) OUTPUT(projections ...jet.Projection) InsertStatement {
+	is.Output.ProjectionList = projections
+	return is
+}
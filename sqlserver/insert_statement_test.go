@@ -0,0 +1,43 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestInsertOutput(t *testing.T) {
+	stmt := table3.INSERT(table3ColInt, table3StrCol).
+		OUTPUT(table3Col1, table3StrCol).
+		VALUES(1, "one").
+		VALUES(2, "two")
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table3 (col_int, col2)
+OUTPUT INSERTED.col1 AS "table3.col1",
+          INSERTED.col2 AS "table3.col2"
+VALUES (@p1, @p2),
+       (@p3, @p4);
+`, 1, "one", 2, "two")
+}
+
+func TestInsertOutputStar(t *testing.T) {
+	stmt := table3.INSERT().
+		OUTPUT(STAR).
+		DEFAULT_VALUES()
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table3
+OUTPUT INSERTED.*
+DEFAULT VALUES;
+`)
+}
+
+func TestInsertQuery(t *testing.T) {
+	stmt := table3.INSERT(table3ColInt).
+		QUERY(SELECT(table1ColInt).TOP(5).FROM(table1))
+
+	assertStatementSql(t, stmt, `
+INSERT INTO db.table3 (col_int)
+SELECT TOP (@p1) table1.col_int AS "table1.col_int"
+FROM db.table1;
+`, int64(5))
+}
@@ -0,0 +1,132 @@
+package sqlserver
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+	"time"
+)
+
+// Keywords
+var (
+	STAR = jet.STAR
+	NULL = jet.NULL
+)
+
+// Bool creates new bool literal expression. SQL Server does not have boolean literals, so value is written as BIT 1 or 0.
+func Bool(value bool) BoolExpression {
+	if value {
+		return CAST(jet.FixedLiteral(1)).AS_BIT()
+	}
+
+	return CAST(jet.FixedLiteral(0)).AS_BIT()
+}
+
+// Int is constructor for 64 bit signed integer expressions literals.
+var Int = jet.Int
+
+// Int8 is constructor for 8 bit signed integer expressions literals.
+var Int8 = jet.Int8
+
+// Int16 is constructor for 16 bit signed integer expressions literals.
+var Int16 = jet.Int16
+
+// Int32 is constructor for 32 bit signed integer expressions literals.
+var Int32 = jet.Int32
+
+// Int64 is constructor for 64 bit signed integer expressions literals.
+var Int64 = jet.Int
+
+// Uint8 is constructor for 8 bit unsigned integer expressions literals.
+var Uint8 = jet.Uint8
+
+// Uint16 is constructor for 16 bit unsigned integer expressions literals.
+var Uint16 = jet.Uint16
+
+// Uint32 is constructor for 32 bit unsigned integer expressions literals.
+var Uint32 = jet.Uint32
+
+// Uint64 is constructor for 64 bit unsigned integer expressions literals.
+var Uint64 = jet.Uint64
+
+// Float creates new float literal expression from float64 value
+var Float = jet.Float
+
+// Decimal creates new decimal literal expression from string value
+var Decimal = jet.Decimal
+
+// String creates new string literal expression
+var String = jet.String
+
+// UUID is a helper function to create string literal expression from uuid object
+// value can be any uuid type with a String method
+var UUID = jet.UUID
+
+// Date creates new date literal expression
+func Date(year int, month time.Month, day int) DateExpression {
+	return CAST(jet.Date(year, month, day)).AS_DATE()
+}
+
+// Time creates new time literal expression
+func Time(hour, minute, second int, nanoseconds ...time.Duration) TimeExpression {
+	return CAST(jet.Time(hour, minute, second, nanoseconds...)).AS_TIME()
+}
+
+// DateTime creates new datetime2 literal expression
+func DateTime(year int, month time.Month, day, hour, minute, second int, nanoseconds ...time.Duration) DateTimeExpression {
+	return CAST(jet.Timestamp(year, month, day, hour, minute, second, nanoseconds...)).AS_DATETIME2()
+}
+
+// Param creates new named parameter expression of the type T, which value has to be set at statement execution time,
+// using statement WithArgs method or prepared statement named arguments. T has to be one of the expression interfaces,
+// and parameter can be used wherever expression of the type T is expected, for instance Param[IntegerExpression]("limit").
+// Named parameters are serialized the same way as the literals of the same type.
+func Param[T Expression](name string) T {
+	var param Expression
+
+	switch any((*T)(nil)).(type) {
+	case *DateExpression:
+		param = CAST(jet.DateParam(name)).AS_DATE()
+	case *TimeExpression:
+		param = CAST(jet.TimeParam(name)).AS_TIME()
+	case *DateTimeExpression:
+		param = CAST(jet.TimestampParam(name)).AS_DATETIME2()
+	default:
+		return jet.Param[T](name)
+	}
+
+	return param.(T)
+}
+
+// BoolParam creates new named parameter bool expression
+func BoolParam(name string) BoolExpression {
+	return Param[BoolExpression](name)
+}
+
+// IntParam creates new named parameter integer expression
+func IntParam(name string) IntegerExpression {
+	return Param[IntegerExpression](name)
+}
+
+// FloatParam creates new named parameter float expression
+func FloatParam(name string) FloatExpression {
+	return Param[FloatExpression](name)
+}
+
+// StringParam creates new named parameter string expression
+func StringParam(name string) StringExpression {
+	return Param[StringExpression](name)
+}
+
+// DateParam creates new named parameter date expression
+func DateParam(name string) DateExpression {
+	return Param[DateExpression](name)
+}
+
+// TimeParam creates new named parameter time expression
+func TimeParam(name string) TimeExpression {
+	return Param[TimeExpression](name)
+}
+
+// DateTimeParam creates new named parameter datetime2 expression
+func DateTimeParam(name string) DateTimeExpression {
+	return Param[DateTimeExpression](name)
+}
@@ -0,0 +1,220 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// MergeStatement is interface of SQL Server MERGE statement
+type MergeStatement interface {
+	ConfigurableStatement
+
+	USING(source ReadableTable) MergeStatement
+	ON(condition BoolExpression) MergeStatement
+
+	// WHEN_MATCHED adds action for the target rows matched by the source rows, and optional additional condition.
+	WHEN_MATCHED(condition ...BoolExpression) mergeMatched
+	// WHEN_NOT_MATCHED adds action for the source rows not matched by the target rows, and optional additional condition.
+	WHEN_NOT_MATCHED(condition ...BoolExpression) mergeNotMatched
+	// WHEN_NOT_MATCHED_BY_SOURCE adds action for the target rows not matched by the source rows, and optional
+	// additional condition.
+	WHEN_NOT_MATCHED_BY_SOURCE(condition ...BoolExpression) mergeMatched
+
+	// OUTPUT returns the merged rows projections. Projection columns are qualified with INSERTED pseudo table,
+	// containing the row values after the insert or update.
+	OUTPUT(projections ...Projection) MergeStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() MergeStatement
+}
+
+type mergeMatched interface {
+	THEN_UPDATE(assigment ColumnAssigment, assigments ...ColumnAssigment) MergeStatement
+	THEN_DELETE() MergeStatement
+}
+
+type mergeNotMatched interface {
+	THEN_INSERT(columns ...jet.Column) mergeInsert
+}
+
+type mergeInsert interface {
+	VALUES(value interface{}, values ...interface{}) MergeStatement
+}
+
+type mergeStatementImpl struct {
+	jet.SerializerStatement
+
+	Merge  jet.ClauseMerge
+	When   clauseMergeWhenList
+	Output jet.ClauseOutput
+}
+
+func newMergeStatement(table Table) MergeStatement {
+	newMerge := &mergeStatementImpl{}
+	newMerge.Merge.Table = table
+	newMerge.Output.Qualifier = "INSERTED"
+
+	return newMerge.init()
+}
+
+func (m *mergeStatementImpl) init() *mergeStatementImpl {
+	m.SerializerStatement = jet.NewStatementImpl(Dialect, jet.MergeStatementType, m,
+		&m.Merge,
+		&m.When,
+		&m.Output,
+	)
+
+	return m
+}
+
+func (m *mergeStatementImpl) Clone() MergeStatement {
+	newMerge := *m
+	jet.CloneSlices(&newMerge)
+
+	return newMerge.init()
+}
+
+func (m *mergeStatementImpl) USING(source ReadableTable) MergeStatement {
+	m.Merge.Using = source
+	return m
+}
+
+func (m *mergeStatementImpl) ON(condition BoolExpression) MergeStatement {
+	m.Merge.On = condition
+	return m
+}
+
+func (m *mergeStatementImpl) WHEN_MATCHED(condition ...BoolExpression) mergeMatched {
+	return m.when("MATCHED", condition)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED(condition ...BoolExpression) mergeNotMatched {
+	return m.when("NOT MATCHED", condition)
+}
+
+func (m *mergeStatementImpl) WHEN_NOT_MATCHED_BY_SOURCE(condition ...BoolExpression) mergeMatched {
+	return m.when("NOT MATCHED BY SOURCE", condition)
+}
+
+func (m *mergeStatementImpl) when(matched string, condition []BoolExpression) *mergeWhenBuilder {
+	when := clauseMergeWhen{Matched: matched}
+
+	if len(condition) > 0 {
+		when.Condition = condition[0]
+	}
+
+	return &mergeWhenBuilder{statement: m, when: when}
+}
+
+func (m *mergeStatementImpl) OUTPUT(projections ...Projection) MergeStatement {
+	m.Output.ProjectionList = projections
+	return m
+}
+
+type mergeWhenBuilder struct {
+	statement *mergeStatementImpl
+	when      clauseMergeWhen
+}
+
+func (b *mergeWhenBuilder) THEN_UPDATE(assigment ColumnAssigment, assigments ...ColumnAssigment) MergeStatement {
+	b.when.Update = append([]ColumnAssigment{assigment}, assigments...)
+	return b.add()
+}
+
+func (b *mergeWhenBuilder) THEN_DELETE() MergeStatement {
+	b.when.Delete = true
+	return b.add()
+}
+
+func (b *mergeWhenBuilder) THEN_INSERT(columns ...jet.Column) mergeInsert {
+	b.when.InsertColumns = jet.UnwidColumnList(columns)
+	return b
+}
+
+func (b *mergeWhenBuilder) VALUES(value interface{}, values ...interface{}) MergeStatement {
+	b.when.InsertValues = jet.UnwindRowFromValues(value, values)
+	return b.add()
+}
+
+func (b *mergeWhenBuilder) add() MergeStatement {
+	b.statement.When = append(b.statement.When, b.when)
+	return b.statement
+}
+
+// clauseMergeWhen is MERGE statement WHEN [NOT] MATCHED [AND condition] THEN action clause
+type clauseMergeWhen struct {
+	Matched   string
+	Condition BoolExpression
+
+	Update        []ColumnAssigment
+	Delete        bool
+	InsertColumns []jet.Column
+	InsertValues  []jet.Serializer
+}
+
+func (w *clauseMergeWhen) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	out.NewLine()
+	out.WriteKeyword("WHEN " + w.Matched)
+
+	condition := w.Condition
+
+	if w.Matched == "NOT MATCHED BY SOURCE" {
+		// target rows of the other tenants are never matched by the source rows
+		condition = out.FilterMergeCondition(condition)
+	}
+
+	if condition != nil {
+		out.WriteKeyword("AND")
+		jet.Serialize(condition, statementType, out)
+	}
+
+	out.WriteKeyword("THEN")
+	out.IncreaseIdent()
+	out.NewLine()
+
+	switch {
+	case w.Delete:
+		out.WriteKeyword("DELETE")
+	case len(w.Update) > 0:
+		out.WriteKeyword("UPDATE SET")
+
+		for i, assigment := range w.Update {
+			if i > 0 {
+				out.WriteString(",")
+			}
+
+			jet.Serialize(assigment, statementType, out)
+		}
+	default:
+		columns, values := out.FilterMergeInsert(w.InsertColumns, w.InsertValues)
+
+		out.WriteKeyword("INSERT")
+
+		if len(columns) > 0 {
+			out.WriteString("(")
+			jet.SerializeColumnNames(columns, out)
+			out.WriteString(")")
+		}
+
+		out.WriteKeyword("VALUES")
+		out.WriteString("(")
+		jet.SerializeClauseList(statementType, values, out)
+		out.WriteByte(')')
+	}
+
+	out.DecreaseIdent()
+}
+
+type clauseMergeWhenList []clauseMergeWhen
+
+func (l *clauseMergeWhenList) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if len(*l) == 0 {
+		panic("jet: MERGE statement has to have at least one WHEN clause")
+	}
+
+	for i := range *l {
+		(*l)[i].Serialize(statementType, out, options...)
+	}
+}
+
+func (l *clauseMergeWhenList) ClauseName() string {
+	return "WHEN"
+}
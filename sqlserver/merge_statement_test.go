@@ -0,0 +1,93 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	source := SELECT(table2ColInt, table2ColStr).FROM(table2).AsTable("source")
+	sourceInt := table2ColInt.From(source)
+	sourceStr := table2ColStr.From(source)
+
+	stmt := table3.MERGE().
+		USING(source).
+		ON(table3ColInt.EQ(sourceInt)).
+		WHEN_MATCHED(table3StrCol.NOT_EQ(sourceStr)).THEN_UPDATE(table3StrCol.SET(sourceStr)).
+		WHEN_NOT_MATCHED().THEN_INSERT(table3ColInt, table3StrCol).VALUES(sourceInt, sourceStr).
+		WHEN_NOT_MATCHED_BY_SOURCE().THEN_DELETE().
+		OUTPUT(table3Col1)
+
+	assertStatementSql(t, stmt, `
+MERGE INTO db.table3
+USING (
+     SELECT table2.col_int AS "table2.col_int",
+          table2.col_str AS "table2.col_str"
+     FROM db.table2
+) AS source
+ON table3.col_int = source.[table2.col_int]
+WHEN MATCHED AND (table3.col2 != source.[table2.col_str]) THEN
+     UPDATE SET col2 = source.[table2.col_str]
+WHEN NOT MATCHED THEN
+     INSERT (col_int, col2) VALUES (source.[table2.col_int], source.[table2.col_str])
+WHEN NOT MATCHED BY SOURCE THEN
+     DELETE
+OUTPUT INSERTED.col1 AS "table3.col1";
+`)
+
+	info := Inspect(stmt)
+	if info.Type != MergeStatementType || !info.Writes || !info.HasClause("WHEN") {
+		t.Errorf("unexpected statement info: %+v", info)
+	}
+}
+
+func TestMergeInvalid(t *testing.T) {
+	assertStatementSqlErr(t, table3.MERGE().ON(table3ColInt.EQ(Int(1))), "jet: MERGE statement USING source is not set")
+	assertStatementSqlErr(t, table3.MERGE().USING(table2).ON(table3ColInt.EQ(table2ColInt)),
+		"jet: MERGE statement has to have at least one WHEN clause")
+}
+
+func TestMergeTableFilter(t *testing.T) {
+	tenantFilter := NewTableFilter(
+		[]Table{table2, table3},
+		func(table Table) BoolExpression {
+			return IntegerColumn("tenant_id").EQ(Int(7))
+		},
+		func(table Table) []ColumnAssigment {
+			return []ColumnAssigment{IntegerColumn("tenant_id").SET(Int(7))}
+		},
+	)
+
+	source := SELECT(table2ColInt, table2ColStr).FROM(table2).AsTable("source")
+	sourceInt := table2ColInt.From(source)
+	sourceStr := table2ColStr.From(source)
+
+	stmt := table3.MERGE().
+		USING(source).
+		ON(table3ColInt.EQ(sourceInt)).
+		WHEN_MATCHED().THEN_UPDATE(table3StrCol.SET(sourceStr)).
+		WHEN_NOT_MATCHED().THEN_INSERT(table3ColInt, table3StrCol).VALUES(sourceInt, sourceStr).
+		WHEN_NOT_MATCHED_BY_SOURCE().THEN_DELETE()
+
+	assertDebugStatementSql(t, stmt.WithTableFilters(tenantFilter), `
+MERGE INTO db.table3
+USING (
+     SELECT table2.col_int AS "table2.col_int",
+          table2.col_str AS "table2.col_str"
+     FROM db.table2
+     WHERE table2.tenant_id = 7
+) AS source
+ON (table3.col_int = source.[table2.col_int]) AND (table3.tenant_id = 7)
+WHEN MATCHED THEN
+     UPDATE SET col2 = source.[table2.col_str]
+WHEN NOT MATCHED THEN
+     INSERT (col_int, col2, tenant_id) VALUES (source.[table2.col_int], source.[table2.col_str], 7)
+WHEN NOT MATCHED BY SOURCE AND (table3.tenant_id = 7) THEN
+     DELETE;
+`)
+
+	assertStatementSqlErr(t, table3.MERGE().
+		USING(table2).
+		ON(table3ColInt.EQ(table2ColInt)).
+		WHEN_MATCHED().THEN_DELETE().
+		WithTableFilters(tenantFilter), "jet: table filter can not be applied to MERGE statement USING table, use sub-query instead")
+}
@@ -0,0 +1,239 @@
+package sqlserver
+
+import (
+	"github.com/go-jet/jet/v2/internal/jet"
+)
+
+// Window function clauses
+var (
+	PARTITION_BY = jet.PARTITION_BY
+	ORDER_BY     = jet.ORDER_BY
+	UNBOUNDED    = jet.UNBOUNDED
+	CURRENT_ROW  = jet.CURRENT_ROW
+)
+
+// PRECEDING window frame clause
+func PRECEDING(offset interface{}) jet.FrameExtent {
+	return jet.PRECEDING(toJetFrameOffset(offset))
+}
+
+// FOLLOWING window frame clause
+func FOLLOWING(offset interface{}) jet.FrameExtent {
+	return jet.FOLLOWING(toJetFrameOffset(offset))
+}
+
+// Window is used to specify window reference from WINDOW clause
+var Window = jet.WindowName
+
+// SelectStatement is interface for SQL Server SELECT statement
+type SelectStatement interface {
+	ConfigurableStatement
+	jet.HasProjections
+	Expression
+
+	DISTINCT() SelectStatement
+	// TOP limits the number of the rows returned by the statement
+	TOP(count int64) SelectStatement
+	FROM(tables ...ReadableTable) SelectStatement
+	WHERE(expression BoolExpression) SelectStatement
+	GROUP_BY(groupByClauses ...GroupByClause) SelectStatement
+	HAVING(boolExpression BoolExpression) SelectStatement
+	WINDOW(name string) windowExpand
+	ORDER_BY(orderByClauses ...OrderByClause) SelectStatement
+	// SEEK sets ORDER BY clause and adds keyset pagination condition to the WHERE clause, selecting the rows ordered
+	// after the row with the last-seen values of the order by expressions. Without last values, first page is selected.
+	// EncodeCursor and DecodeCursor can be used to pass the last values between the requests.
+	SEEK(orderBy []OrderByClause, lastValues ...interface{}) SelectStatement
+	// OFFSET skips the offset rows of the ordered result. Statement has to have ORDER BY clause.
+	OFFSET(offset int64) SelectStatement
+	// FETCH_NEXT limits the number of the rows returned after the OFFSET rows. If OFFSET is not set, 0 rows are skipped.
+	FETCH_NEXT(count int64) SelectStatement
+
+	UNION(rhs SelectStatement) setStatement
+	UNION_ALL(rhs SelectStatement) setStatement
+	INTERSECT(rhs SelectStatement) setStatement
+	EXCEPT(rhs SelectStatement) setStatement
+
+	AsTable(alias string) SelectTable
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() SelectStatement
+}
+
+// SELECT creates new SelectStatement with list of projections
+func SELECT(projection Projection, projections ...Projection) SelectStatement {
+	return newSelectStatement(nil, append([]Projection{projection}, projections...))
+}
+
+func newSelectStatement(table ReadableTable, projections []Projection) SelectStatement {
+	newSelect := &selectStatementImpl{}
+	newSelect.Select.ProjectionList = projections
+	if table != nil {
+		newSelect.From.Tables = []jet.Serializer{table}
+	}
+
+	return newSelect.init()
+}
+
+func (s *selectStatementImpl) init() *selectStatementImpl {
+	s.ExpressionStatement = jet.NewExpressionStatementImpl(Dialect, jet.SelectStatementType, s, &s.Select,
+		&s.From, &s.Where, &s.GroupBy, &s.Having, &s.Window, &s.OrderBy, &s.OffsetFetch)
+
+	s.setOperatorsImpl.parent = s
+
+	return s
+}
+
+func (s *selectStatementImpl) Clone() SelectStatement {
+	newSelect := *s
+	jet.CloneSlices(&newSelect)
+
+	return newSelect.init()
+}
+
+type selectStatementImpl struct {
+	jet.ExpressionStatement
+	setOperatorsImpl
+
+	Select      jet.ClauseSelect
+	From        jet.ClauseFrom
+	Where       jet.ClauseWhere
+	GroupBy     jet.ClauseGroupBy
+	Having      jet.ClauseHaving
+	Window      jet.ClauseWindow
+	OrderBy     jet.ClauseOrderBy
+	OffsetFetch clauseOffsetFetch
+}
+
+func (s *selectStatementImpl) DISTINCT() SelectStatement {
+	s.Select.Distinct = true
+	return s
+}
+
+func (s *selectStatementImpl) TOP(count int64) SelectStatement {
+	s.Select.Top = Int(count)
+	return s
+}
+
+func (s *selectStatementImpl) FROM(tables ...ReadableTable) SelectStatement {
+	s.From.Tables = readableTablesToSerializerList(tables)
+	return s
+}
+
+func (s *selectStatementImpl) WHERE(condition BoolExpression) SelectStatement {
+	s.Where.Condition = condition
+	return s
+}
+
+func (s *selectStatementImpl) GROUP_BY(groupByClauses ...GroupByClause) SelectStatement {
+	s.GroupBy.List = groupByClauses
+	return s
+}
+
+func (s *selectStatementImpl) HAVING(boolExpression BoolExpression) SelectStatement {
+	s.Having.Condition = boolExpression
+	return s
+}
+
+func (s *selectStatementImpl) WINDOW(name string) windowExpand {
+	s.Window.Definitions = append(s.Window.Definitions, jet.WindowDefinition{Name: name})
+	return windowExpand{selectStatement: s}
+}
+
+func (s *selectStatementImpl) ORDER_BY(orderByClauses ...OrderByClause) SelectStatement {
+	s.OrderBy.List = orderByClauses
+	return s
+}
+
+func (s *selectStatementImpl) SEEK(orderBy []OrderByClause, lastValues ...interface{}) SelectStatement {
+	s.OrderBy.List = orderBy
+	s.Where.Keyset = nil
+
+	if len(lastValues) > 0 {
+		s.Where.Keyset = jet.KeysetCondition(orderBy, lastValues)
+	}
+
+	return s
+}
+
+func (s *selectStatementImpl) OFFSET(offset int64) SelectStatement {
+	s.OffsetFetch.Offset = Int(offset)
+	return s
+}
+
+func (s *selectStatementImpl) FETCH_NEXT(count int64) SelectStatement {
+	s.OffsetFetch.Fetch = Int(count)
+	return s
+}
+
+func (s *selectStatementImpl) AsTable(alias string) SelectTable {
+	return newSelectTable(s, alias)
+}
+
+//-----------------------------------------------------
+
+// clauseOffsetFetch is SQL Server OFFSET n ROWS FETCH NEXT m ROWS ONLY clause
+type clauseOffsetFetch struct {
+	Offset IntegerExpression
+	Fetch  IntegerExpression
+}
+
+func (c *clauseOffsetFetch) Serialize(statementType jet.StatementType, out *jet.SQLBuilder, options ...jet.SerializeOption) {
+	if c.Offset == nil && c.Fetch == nil {
+		return
+	}
+
+	offset := c.Offset
+
+	if offset == nil {
+		offset = IntExp(jet.FixedLiteral(0))
+	}
+
+	out.NewLine()
+	out.WriteKeyword("OFFSET")
+	jet.Serialize(offset, statementType, out)
+	out.WriteKeyword("ROWS")
+
+	if c.Fetch == nil {
+		return
+	}
+
+	out.NewLine()
+	out.WriteKeyword("FETCH NEXT")
+	jet.Serialize(c.Fetch, statementType, out)
+	out.WriteKeyword("ROWS ONLY")
+}
+
+func (c *clauseOffsetFetch) ClauseName() string {
+	return "OFFSET"
+}
+
+type windowExpand struct {
+	selectStatement *selectStatementImpl
+}
+
+func (w windowExpand) AS(window ...jet.Window) SelectStatement {
+	if len(window) == 0 {
+		return w.selectStatement
+	}
+	windowsDefinition := w.selectStatement.Window.Definitions
+	windowsDefinition[len(windowsDefinition)-1].Window = window[0]
+	return w.selectStatement
+}
+
+func toJetFrameOffset(offset interface{}) jet.Serializer {
+	if offset == UNBOUNDED {
+		return jet.UNBOUNDED
+	}
+
+	return jet.FixedLiteral(offset)
+}
+
+func readableTablesToSerializerList(tables []ReadableTable) []jet.Serializer {
+	var ret []jet.Serializer
+	for _, table := range tables {
+		ret = append(ret, table)
+	}
+	return ret
+}
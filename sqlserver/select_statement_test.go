@@ -0,0 +1,90 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestInvalidSelect(t *testing.T) {
+	assertStatementSqlErr(t, SELECT(nil), "jet: Projection is nil")
+}
+
+func TestSelectTop(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).DISTINCT().TOP(10).FROM(table1).ORDER_BY(table1ColInt), `
+SELECT DISTINCT TOP (@p1) table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY table1.col_int;
+`, int64(10))
+}
+
+func TestSelectOffsetFetch(t *testing.T) {
+	assertStatementSql(t, SELECT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt).OFFSET(20).FETCH_NEXT(10), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY table1.col_int
+OFFSET @p1 ROWS
+FETCH NEXT @p2 ROWS ONLY;
+`, int64(20), int64(10))
+
+	assertDebugStatementSql(t, SELECT(table1ColInt).FROM(table1).ORDER_BY(table1ColInt).FETCH_NEXT(10), `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+ORDER BY table1.col_int
+OFFSET 0 ROWS
+FETCH NEXT 10 ROWS ONLY;
+`)
+}
+
+func TestSelectSeek(t *testing.T) {
+	stmt := SELECT(table1ColInt).
+		FROM(table1).
+		SEEK([]OrderByClause{table1ColInt.ASC(), table1ColFloat.ASC()}, 10, 2.5).
+		FETCH_NEXT(20)
+
+	assertDebugStatementSql(t, stmt, `
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+WHERE (table1.col_int > 10) OR ((table1.col_int = 10) AND (table1.col_float > 2.5))
+ORDER BY table1.col_int ASC, table1.col_float ASC
+OFFSET 0 ROWS
+FETCH NEXT 20 ROWS ONLY;
+`)
+}
+
+func TestSelectSetOperators(t *testing.T) {
+	stmt := SELECT(table1ColInt).FROM(table1).
+		EXCEPT(SELECT(table2ColInt).FROM(table2)).
+		ORDER_BY(table1ColInt).
+		OFFSET(5)
+
+	assertDebugStatementSql(t, stmt, `
+
+SELECT table1.col_int AS "table1.col_int"
+FROM db.table1
+
+EXCEPT
+
+SELECT table2.col_int AS "table2.col_int"
+FROM db.table2
+ORDER BY "table1.col_int"
+OFFSET 5 ROWS;
+`)
+}
+
+func TestSelectWith(t *testing.T) {
+	cte := CTE("cte")
+
+	stmt := WITH(
+		cte.AS(SELECT(table1ColInt).FROM(table1)),
+	)(
+		SELECT(cte.AllColumns()).FROM(cte),
+	)
+
+	assertDebugStatementSql(t, stmt, `
+WITH cte AS (
+     SELECT table1.col_int AS "table1.col_int"
+     FROM db.table1
+)
+SELECT cte.[table1.col_int] AS "table1.col_int"
+FROM cte;
+`)
+}
@@ -0,0 +1,96 @@
+package sqlserver
+
+import "github.com/go-jet/jet/v2/internal/jet"
+
+// UpdateStatement is interface of SQL UPDATE statement
+type UpdateStatement interface {
+	jet.ConfigurableStatement
+
+	SET(value interface{}, values ...interface{}) UpdateStatement
+	MODEL(data interface{}) UpdateStatement
+
+	// OUTPUT returns the updated rows projections. Projection columns are qualified with INSERTED pseudo table,
+	// containing the row values after the update.
+	OUTPUT(projections ...Projection) UpdateStatement
+	FROM(tables ...ReadableTable) UpdateStatement
+	WHERE(expression BoolExpression) UpdateStatement
+
+	// Clone returns a copy of the statement with its own clauses. Builder methods modify the statement they are
+	// called on, so Clone should be used to derive new statements from the shared base statement.
+	Clone() UpdateStatement
+}
+
+type updateStatementImpl struct {
+	jet.SerializerStatement
+
+	Update jet.ClauseUpdate
+	Set    jet.SetClause
+	SetNew jet.SetClauseNew
+	Output jet.ClauseOutput
+	From   jet.ClauseFrom
+	Where  jet.ClauseWhere
+}
+
+func newUpdateStatement(table Table, columns []jet.Column) UpdateStatement {
+	update := &updateStatementImpl{}
+	update.Update.Table = table
+	update.Set.Columns = columns
+	update.Output.Qualifier = "INSERTED"
+	update.Where.Mandatory = true
+
+	return update.init()
+}
+
+func (u *updateStatementImpl) init() *updateStatementImpl {
+	u.SerializerStatement = jet.NewStatementImpl(Dialect, jet.UpdateStatementType, u,
+		&u.Update,
+		&u.Set,
+		&u.SetNew,
+		&u.Output,
+		&u.From,
+		&u.Where)
+
+	return u
+}
+
+func (u *updateStatementImpl) Clone() UpdateStatement {
+	newUpdate := *u
+	jet.CloneSlices(&newUpdate)
+
+	return newUpdate.init()
+}
+
+func (u *updateStatementImpl) SET(value interface{}, values ...interface{}) UpdateStatement {
+	columnAssigment, isColumnAssigment := value.(ColumnAssigment)
+
+	if isColumnAssigment {
+		u.SetNew = []ColumnAssigment{columnAssigment}
+		for _, value := range values {
+			u.SetNew = append(u.SetNew, value.(ColumnAssigment))
+		}
+	} else {
+		u.Set.Values = jet.UnwindRowFromValues(value, values)
+	}
+
+	return u
+}
+
+func (u *updateStatementImpl) MODEL(data interface{}) UpdateStatement {
+	u.Set.Columns, u.Set.Values, u.Where.VersionCheck = jet.UnwindVersionedRowFromModel(u.Update.Table, u.Set.Columns, data)
+	return u
+}
+
+func (u *updateStatementImpl) OUTPUT(projections ...Projection) UpdateStatement {
+	u.Output.ProjectionList = projections
+	return u
+}
+
+func (u *updateStatementImpl) FROM(tables ...ReadableTable) UpdateStatement {
+	u.From.Tables = readableTablesToSerializerList(tables)
+	return u
+}
+
+func (u *updateStatementImpl) WHERE(expression BoolExpression) UpdateStatement {
+	u.Where.Condition = expression
+	return u
+}
@@ -0,0 +1,35 @@
+package sqlserver
+
+import (
+	"testing"
+)
+
+func TestUpdateOutput(t *testing.T) {
+	stmt := table3.UPDATE().
+		SET(table3StrCol.SET(String("new"))).
+		OUTPUT(table3Col1, table3StrCol).
+		WHERE(table3ColInt.EQ(Int(2)))
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table3
+SET col2 = @p1
+OUTPUT INSERTED.col1 AS "table3.col1",
+          INSERTED.col2 AS "table3.col2"
+WHERE table3.col_int = @p2;
+`, "new", int64(2))
+}
+
+func TestUpdateFrom(t *testing.T) {
+	stmt := table1.UPDATE(table1ColString).
+		SET(table2ColStr).
+		FROM(table1.INNER_JOIN(table2, table1ColInt.EQ(table2ColInt))).
+		WHERE(table2ColFloat.GT(Float(1.5)))
+
+	assertStatementSql(t, stmt, `
+UPDATE db.table1
+SET col_string = table2.col_str
+FROM db.table1
+     INNER JOIN db.table2 ON (table1.col_int = table2.col_int)
+WHERE table2.col_float > @p1;
+`, 1.5)
+}
@@ -61,6 +61,7 @@ func TestGeneratorTemplate_Schema_ChangePath(t *testing.T) {
 	file2.Exists(t, tempTestDir, "new/schema/path/table/actor.go")
 	file2.Exists(t, tempTestDir, "new/schema/path/view/actor_info.go")
 	file2.Exists(t, tempTestDir, "new/schema/path/enum/film_rating.go")
+	file2.Exists(t, tempTestDir, "new/schema/path/set/film_special_features.go")
 }
 
 func TestGeneratorTemplate_Model_SkipGeneration(t *testing.T) {
@@ -390,7 +391,7 @@ func TestGeneratorTemplate_Model_ChangeFieldTypes(t *testing.T) {
 	require.Contains(t, data, "\"database/sql\"")
 	require.Contains(t, data, "Description        sql.NullString")
 	require.Contains(t, data, "ReleaseYear        *int16")
-	require.Contains(t, data, "SpecialFeatures    sql.NullString")
+	require.Contains(t, data, "SpecialFeatures    *FilmSpecialFeatures")
 }
 
 func TestGeneratorTemplate_SQLBuilder_ChangeColumnTypes(t *testing.T) {
@@ -173,10 +173,12 @@ func TestIgnoreTablesViewsEnums(t *testing.T) {
 
 			testutils.AssertFileNamesEqual(t, genTestDir3+"/dvds/enum", "nicer_but_slower_film_list_rating.go")
 
+			testutils.AssertFileNamesEqual(t, genTestDir3+"/dvds/set", "film_special_features.go")
+
 			testutils.AssertFileNamesEqual(t, genTestDir3+"/dvds/model",
 				"customer.go", "film.go", "film_actor.go", "film_category.go", "film_text.go", "inventory.go", "language.go",
-				"payment.go", "nicer_but_slower_film_list_rating.go", "nicer_but_slower_film_list.go", "sales_by_film_category.go",
-				"sales_by_store.go", "staff_list.go")
+				"payment.go", "nicer_but_slower_film_list_rating.go", "film_special_features.go", "nicer_but_slower_film_list.go",
+				"sales_by_film_category.go", "sales_by_store.go", "staff_list.go")
 		})
 	}
 }
@@ -201,11 +203,14 @@ func assertGeneratedFiles(t *testing.T) {
 	testutils.AssertFileNamesEqual(t, genTestDir3+"/dvds/enum", "film_rating.go", "film_list_rating.go", "nicer_but_slower_film_list_rating.go")
 	testutils.AssertFileContent(t, genTestDir3+"/dvds/enum/film_rating.go", mpaaRatingEnumFile)
 
+	// Sets SQL Builder files
+	testutils.AssertFileNamesEqual(t, genTestDir3+"/dvds/set", "film_special_features.go")
+
 	// Model files
 	testutils.AssertFileNamesEqual(t, genTestDir3+"/dvds/model", "actor.go", "address.go", "category.go", "city.go", "country.go",
 		"customer.go", "film.go", "film_actor.go", "film_category.go", "film_text.go", "inventory.go", "language.go",
 		"payment.go", "rental.go", "staff.go", "store.go",
-		"film_rating.go", "film_list_rating.go", "nicer_but_slower_film_list_rating.go",
+		"film_rating.go", "film_list_rating.go", "nicer_but_slower_film_list_rating.go", "film_special_features.go",
 		"actor_info.go", "film_list.go", "nicer_but_slower_film_list.go", "sales_by_film_category.go",
 		"customer_list.go", "sales_by_store.go", "staff_list.go")
 
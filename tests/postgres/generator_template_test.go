@@ -422,7 +422,7 @@ func TestGeneratorTemplate_Model_ChangeFieldTypes(t *testing.T) {
 									switch defaultTableModelField.Type.Name {
 									case "*string":
 										defaultTableModelField.Type = template.NewType(sql.NullString{})
-									case "*int32":
+									case "*int32", "*Year":
 										defaultTableModelField.Type = template.NewType(sql.NullInt32{})
 									case "*int64":
 										defaultTableModelField.Type = template.NewType(sql.NullInt64{})
@@ -174,7 +174,7 @@ func TestGeneratorIgnoreTables(t *testing.T) {
 				"customer.go", "film_actor.go", "film_category.go", "inventory.go", "language.go",
 				"payment.go", "rental.go", "staff.go", "store.go",
 				"nicer_but_slower_film_list.go", "sales_by_film_category.go",
-				"customer_list.go", "sales_by_store.go")
+				"customer_list.go", "sales_by_store.go", "year.go")
 		})
 	}
 }
@@ -306,7 +306,7 @@ func assertGeneratedFiles(t *testing.T) {
 		"customer.go", "film.go", "film_actor.go", "film_category.go", "inventory.go", "language.go",
 		"payment.go", "rental.go", "staff.go", "store.go", "mpaa_rating.go",
 		"actor_info.go", "film_list.go", "nicer_but_slower_film_list.go", "sales_by_film_category.go",
-		"customer_list.go", "sales_by_store.go", "staff_list.go")
+		"customer_list.go", "sales_by_store.go", "staff_list.go", "year.go")
 
 	testutils.AssertFileContent(t, "./.gentestdata2/jetdb/dvds/model/actor.go", actorModelFile)
 }
@@ -208,7 +208,7 @@ func TestScanToStruct(t *testing.T) {
 
 		err := query.Query(db, &dest)
 		require.Error(t, err)
-		require.EqualError(t, err, "jet: can't scan int64('\\x01') to 'InventoryID uuid.UUID': Scan: unable to scan type int64 into UUID")
+		require.EqualError(t, err, "jet: can't scan int64('\\x01') to 'InventoryID uuid.UUID' (column \"inventory.inventory_id\", row 1, path postgres.Inventory.InventoryID): Scan: unable to scan type int64 into UUID")
 	})
 
 	t.Run("type mismatch base type", func(t *testing.T) {
@@ -221,7 +221,7 @@ func TestScanToStruct(t *testing.T) {
 
 		err := query.OFFSET(10).Query(db, &dest)
 		require.Error(t, err)
-		require.EqualError(t, err, "jet: can't assign int64('\\x02') to 'FilmID bool': can't assign int64(2) to bool")
+		require.EqualError(t, err, "jet: can't assign int64('\\x02') to 'FilmID bool' (column \"inventory.film_id\", row 1, path []postgres.Inventory[0].FilmID): can't assign int64(2) to bool")
 	})
 }
 
@@ -474,7 +474,7 @@ func TestScanToSlice(t *testing.T) {
 
 			err := query.Query(db, &dest)
 			require.Error(t, err)
-			require.EqualError(t, err, `jet: can't append int64 to []bool slice: can't assign int64(2) to bool`)
+			require.EqualError(t, err, `jet: can't append int64 to []bool slice (column "inventory.inventory_id", row 2, path []bool[1]): can't assign int64(2) to bool`)
 		})
 	})
 
@@ -937,9 +937,9 @@ func TestScanNumericToIntegerError(t *testing.T) {
 	require.Error(t, err)
 
 	if isPgxDriver() {
-		require.Contains(t, err.Error(), `jet: can't assign string("1234567890.111") to 'Integer int32': converting driver.Value type string ("1234567890.111") to a int64: invalid syntax`)
+		require.Contains(t, err.Error(), `jet: can't assign string("1234567890.111") to 'Integer int32' (column "integer", row 1, path struct { Integer int32 }.Integer): converting driver.Value type string ("1234567890.111") to a int64: invalid syntax`)
 	} else {
-		require.Contains(t, err.Error(), `jet: can't assign []uint8("1234567890.111") to 'Integer int32': converting driver.Value type []uint8 ("1234567890.111") to a int64: invalid syntax`)
+		require.Contains(t, err.Error(), `jet: can't assign []uint8("1234567890.111") to 'Integer int32' (column "integer", row 1, path struct { Integer int32 }.Integer): converting driver.Value type []uint8 ("1234567890.111") to a int64: invalid syntax`)
 	}
 
 }
@@ -1152,7 +1152,7 @@ var film1 = model.Film{
 	FilmID:          1,
 	Title:           "Academy Dinosaur",
 	Description:     testutils.StringPtr("A Epic Drama of a Feminist And a Mad Scientist who must Battle a Teacher in The Canadian Rockies"),
-	ReleaseYear:     testutils.Int32Ptr(2006),
+	ReleaseYear:     &releaseYear2006,
 	LanguageID:      1,
 	RentalDuration:  6,
 	RentalRate:      0.99,
@@ -1168,7 +1168,7 @@ var film2 = model.Film{
 	FilmID:          2,
 	Title:           "Ace Goldfinger",
 	Description:     testutils.StringPtr("A Astounding Epistle of a Database Administrator And a Explorer who must Find a Car in Ancient China"),
-	ReleaseYear:     testutils.Int32Ptr(2006),
+	ReleaseYear:     &releaseYear2006,
 	LanguageID:      1,
 	RentalDuration:  3,
 	RentalRate:      4.99,
@@ -1187,6 +1187,7 @@ var store1 = model.Store{
 	LastUpdate:     *testutils.TimestampWithoutTimeZone("2006-02-15 09:57:12", 0),
 }
 
+var releaseYear2006 = model.Year(2006)
 var pgRating = model.MpaaRating_Pg
 var gRating = model.MpaaRating_G
 
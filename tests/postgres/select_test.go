@@ -1829,7 +1829,7 @@ ORDER BY film.film_id ASC;
 		FilmID:          2,
 		Title:           "Ace Goldfinger",
 		Description:     testutils.StringPtr("A Astounding Epistle of a Database Administrator And a Explorer who must Find a Car in Ancient China"),
-		ReleaseYear:     testutils.Int32Ptr(2006),
+		ReleaseYear:     &releaseYear2006,
 		LanguageID:      1,
 		RentalRate:      4.99,
 		Length:          testutils.Int16Ptr(48),